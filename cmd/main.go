@@ -16,7 +16,10 @@ import (
 	"github.com/grachmannico95/mileapp-test-be/internal/http/server"
 	"github.com/grachmannico95/mileapp-test-be/internal/repository"
 	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/pkg/blobstore"
 	"github.com/grachmannico95/mileapp-test-be/pkg/database"
+	"github.com/grachmannico95/mileapp-test-be/pkg/mailer"
+	"github.com/grachmannico95/mileapp-test-be/pkg/ratelimit"
 )
 
 func main() {
@@ -51,20 +54,95 @@ func main() {
 	}
 	log.Println("database indexes created successfully")
 
+	// accounts stored before emails were normalized must stay reachable
+	if err := database.NormalizeUserEmails(ctx, mongoDB.Database); err != nil {
+		log.Fatalf("failed to normalize user emails: %v", err)
+	}
+
 	// inject repositories
 	userRepo := repository.NewUserRepository(mongoDB.Database)
 	taskRepo := repository.NewTaskRepository(mongoDB.Database)
+	inviteRepo := repository.NewInviteRepository(mongoDB.Database)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(mongoDB.Database)
+	revokedTokenRepo := repository.NewRevokedTokenRepository(mongoDB.Database)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(mongoDB.Database)
+	passwordResetTokenRepo := repository.NewPasswordResetTokenRepository(mongoDB.Database)
+	personalAccessTokenRepo := repository.NewPersonalAccessTokenRepository(mongoDB.Database)
+	sessionRepo := repository.NewSessionRepository(mongoDB.Database)
+	auditLogRepo := repository.NewAuditLogRepository(mongoDB.Database)
+	projectRepo := repository.NewProjectRepository(mongoDB.Database)
+	labelRepo := repository.NewLabelRepository(mongoDB.Database)
+	commentRepo := repository.NewCommentRepository(mongoDB.Database)
+
+	// init mailer
+	var mail mailer.Mailer
+	switch cfg.Mailer.Driver {
+	case "smtp":
+		mail = mailer.NewSMTPMailer(cfg.Mailer.SMTPHost, cfg.Mailer.SMTPPort, cfg.Mailer.SMTPUsername, cfg.Mailer.SMTPPassword, cfg.Mailer.From)
+	default:
+		mail = mailer.NewLogMailer(cfg.Mailer.LogFile)
+	}
+
+	// init blob store
+	var blobStore blobstore.BlobStore
+	switch cfg.BlobStore.Driver {
+	case "s3":
+		blobStore, err = blobstore.NewS3Store(cfg.BlobStore.S3Endpoint, cfg.BlobStore.S3Region, cfg.BlobStore.S3Bucket, cfg.BlobStore.S3AccessKey, cfg.BlobStore.S3SecretKey)
+		if err != nil {
+			log.Fatalf("failed to init blob store: %v", err)
+		}
+	default:
+		blobStore = blobstore.NewLocalStore(cfg.BlobStore.LocalDir)
+	}
 
 	// inject services
-	authService := service.NewAuthService(userRepo, cfg)
-	taskService := service.NewTaskService(taskRepo)
+	auditService := service.NewAuditService(auditLogRepo, cfg)
+	tokenService := service.NewTokenService(userRepo, revokedTokenRepo, refreshTokenRepo, sessionRepo, cfg)
+	emailVerificationService := service.NewEmailVerificationService(userRepo, mail, cfg)
+	mfaService := service.NewMFAService(userRepo, cfg)
+	sessionService := service.NewSessionService(sessionRepo, tokenService, auditService)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(userRepo, personalAccessTokenRepo, auditService, cfg)
+	authService := service.NewAuthService(userRepo, inviteRepo, refreshTokenRepo, loginAttemptRepo, sessionRepo, tokenService, emailVerificationService, mfaService, auditService, cfg)
+	taskService := service.NewTaskService(taskRepo, projectRepo, userRepo, labelRepo, commentRepo, blobStore, auditService)
+	userService := service.NewUserService(userRepo, tokenService, cfg)
+	oidcService := service.NewOIDCService(userRepo, authService, tokenService, cfg)
+	projectService := service.NewProjectService(projectRepo, taskRepo, commentRepo, userRepo, blobStore, auditService, cfg)
+	labelService := service.NewLabelService(labelRepo, taskRepo, auditService)
+	commentService := service.NewCommentService(commentRepo, taskRepo, projectRepo, auditService)
+	attachmentService := service.NewAttachmentService(taskRepo, projectRepo, blobStore, auditService, cfg)
+	impersonationService := service.NewImpersonationService(userRepo, tokenService, auditService, cfg)
+	passwordResetService := service.NewPasswordResetService(userRepo, passwordResetTokenRepo, tokenService, mail, cfg)
+
+	// init rate limit store
+	var rateLimitStore ratelimit.Store
+	if cfg.RateLimit.Enabled {
+		switch cfg.RateLimit.Store {
+		case "mongo":
+			rateLimitStore = ratelimit.NewMongoStore(mongoDB.Database)
+		default:
+			rateLimitStore = ratelimit.NewMemoryStore()
+		}
+	}
 
 	// inject handlers
 	authHandler := handler.NewAuthHandler(authService, cfg)
 	taskHandler := handler.NewTaskHandler(taskService)
+	userHandler := handler.NewUserHandler(userService)
+	passwordResetHandler := handler.NewPasswordResetHandler(passwordResetService)
+	emailVerificationHandler := handler.NewEmailVerificationHandler(emailVerificationService)
+	mfaHandler := handler.NewMFAHandler(mfaService)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenService)
+	oidcHandler := handler.NewOIDCHandler(oidcService, authHandler, cfg)
+	sessionHandler := handler.NewSessionHandler(sessionService)
+	auditHandler := handler.NewAuditHandler(auditService)
+	projectHandler := handler.NewProjectHandler(projectService)
+	labelHandler := handler.NewLabelHandler(labelService)
+	commentHandler := handler.NewCommentHandler(commentService)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService, cfg)
+	impersonationHandler := handler.NewImpersonationHandler(impersonationService, authHandler, cfg)
 
 	// init router
-	r := router.NewRouter(cfg, authHandler, taskHandler)
+	r := router.NewRouter(cfg, tokenService, personalAccessTokenService, sessionService, auditService, rateLimitStore, authHandler, taskHandler, userHandler, passwordResetHandler, emailVerificationHandler, mfaHandler, personalAccessTokenHandler, oidcHandler, sessionHandler, auditHandler, impersonationHandler, projectHandler, labelHandler, commentHandler, attachmentHandler)
 
 	// init server
 	srv := server.NewServer(cfg.Server.Port, r)
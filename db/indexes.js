@@ -13,11 +13,50 @@ async function setupCollectionsAndIndexes(uri) {
     await db.createCollection("tasks");
     console.log("created collection: tasks");
 
+    await db.createCollection("invites");
+    console.log("created collection: invites");
+
+    await db.createCollection("refresh_tokens");
+    console.log("created collection: refresh_tokens");
+
+    await db.createCollection("revoked_tokens");
+    console.log("created collection: revoked_tokens");
+
+    await db.createCollection("rate_limits");
+    console.log("created collection: rate_limits");
+
+    await db.createCollection("login_attempts");
+    console.log("created collection: login_attempts");
+
+    await db.createCollection("password_reset_tokens");
+    console.log("created collection: password_reset_tokens");
+
+    await db.createCollection("personal_access_tokens");
+    console.log("created collection: personal_access_tokens");
+
+    await db.createCollection("sessions");
+    console.log("created collection: sessions");
+
+    await db.createCollection("audit_logs");
+    console.log("created collection: audit_logs");
+
+    await db.createCollection("projects");
+    console.log("created collection: projects");
+
+    await db.createCollection("labels");
+    console.log("created collection: labels");
+
+    await db.createCollection("comments");
+    console.log("created collection: comments");
+
     const usersCollection = db.collection("users");
 
     await usersCollection.createIndex({ email: 1 }, { unique: true });
     console.log("created index on users.email (unique)");
 
+    await usersCollection.createIndex({ role: 1 });
+    console.log("created index on users.role");
+
     const tasksCollection = db.collection("tasks");
 
     await tasksCollection.createIndex({ status: 1 });
@@ -32,6 +71,126 @@ async function setupCollectionsAndIndexes(uri) {
     await tasksCollection.createIndex({ created_at: -1 });
     console.log("created index on tasks.created_at (descending)");
 
+    await tasksCollection.createIndex({ user_id: 1, created_at: -1 });
+    console.log("created index on tasks.user_id + tasks.created_at (descending)");
+
+    await tasksCollection.createIndex({ user_id: 1, due_date: 1 });
+    console.log("created index on tasks.user_id + tasks.due_date");
+
+    await tasksCollection.createIndex({ user_id: 1, priority: 1 });
+    console.log("created index on tasks.user_id + tasks.priority");
+
+    await tasksCollection.createIndex({ project_id: 1, created_at: -1 });
+    console.log("created index on tasks.project_id + tasks.created_at (descending)");
+
+    await tasksCollection.createIndex({ assignee_ids: 1, created_at: -1 });
+    console.log("created index on tasks.assignee_ids + tasks.created_at (descending)");
+
+    await tasksCollection.createIndex({ tags: 1, created_at: -1 });
+    console.log("created index on tasks.tags + tasks.created_at (descending)");
+
+    const invitesCollection = db.collection("invites");
+
+    await invitesCollection.createIndex({ code: 1 }, { unique: true });
+    console.log("created index on invites.code (unique)");
+
+    const refreshTokensCollection = db.collection("refresh_tokens");
+
+    await refreshTokensCollection.createIndex({ token_hash: 1 }, { unique: true });
+    console.log("created index on refresh_tokens.token_hash (unique)");
+
+    await refreshTokensCollection.createIndex({ family_id: 1 });
+    console.log("created index on refresh_tokens.family_id");
+
+    await refreshTokensCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on refresh_tokens.expires_at (ttl)");
+
+    const revokedTokensCollection = db.collection("revoked_tokens");
+
+    await revokedTokensCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on revoked_tokens.expires_at (ttl)");
+
+    const rateLimitsCollection = db.collection("rate_limits");
+
+    await rateLimitsCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on rate_limits.expires_at (ttl)");
+
+    const loginAttemptsCollection = db.collection("login_attempts");
+
+    await loginAttemptsCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on login_attempts.expires_at (ttl)");
+
+    const passwordResetTokensCollection = db.collection("password_reset_tokens");
+
+    await passwordResetTokensCollection.createIndex({ token_hash: 1 }, { unique: true });
+    console.log("created index on password_reset_tokens.token_hash (unique)");
+
+    await passwordResetTokensCollection.createIndex({ user_id: 1 });
+    console.log("created index on password_reset_tokens.user_id");
+
+    await passwordResetTokensCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on password_reset_tokens.expires_at (ttl)");
+
+    const personalAccessTokensCollection = db.collection("personal_access_tokens");
+
+    await personalAccessTokensCollection.createIndex({ token_hash: 1 }, { unique: true });
+    console.log("created index on personal_access_tokens.token_hash (unique)");
+
+    await personalAccessTokensCollection.createIndex({ user_id: 1, created_at: -1 });
+    console.log("created index on personal_access_tokens.user_id + personal_access_tokens.created_at");
+
+    const sessionsCollection = db.collection("sessions");
+
+    await sessionsCollection.createIndex({ family_id: 1 }, { unique: true });
+    console.log("created index on sessions.family_id (unique)");
+
+    await sessionsCollection.createIndex({ user_id: 1, last_seen_at: -1 });
+    console.log("created index on sessions.user_id + sessions.last_seen_at");
+
+    await sessionsCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on sessions.expires_at (ttl)");
+
+    const auditLogsCollection = db.collection("audit_logs");
+
+    await auditLogsCollection.createIndex({ created_at: -1 });
+    console.log("created index on audit_logs.created_at (descending)");
+
+    await auditLogsCollection.createIndex({ actor_id: 1, created_at: -1 });
+    console.log("created index on audit_logs.actor_id + audit_logs.created_at");
+
+    await auditLogsCollection.createIndex({ impersonator_id: 1, created_at: -1 }, { sparse: true });
+    console.log("created index on audit_logs.impersonator_id + audit_logs.created_at (sparse)");
+
+    await auditLogsCollection.createIndex({ action: 1, created_at: -1 });
+    console.log("created index on audit_logs.action + audit_logs.created_at");
+
+    await auditLogsCollection.createIndex({ target_type: 1, target_id: 1, created_at: -1 });
+    console.log("created index on audit_logs.target_type + audit_logs.target_id + audit_logs.created_at");
+
+    await auditLogsCollection.createIndex({ expires_at: 1 }, { expireAfterSeconds: 0 });
+    console.log("created index on audit_logs.expires_at (ttl)");
+
+    const projectsCollection = db.collection("projects");
+
+    await projectsCollection.createIndex({ "members.user_id": 1 });
+    console.log("created index on projects.members.user_id");
+
+    const labelsCollection = db.collection("labels");
+
+    await labelsCollection.createIndex({ name: 1 }, { unique: true });
+    console.log("created index on labels.name (unique)");
+
+    const commentsCollection = db.collection("comments");
+
+    await commentsCollection.createIndex({ task_id: 1, created_at: 1 });
+    console.log("created index on comments.task_id + comments.created_at");
+
+    await commentsCollection.createIndex({ parent_id: 1, created_at: 1 }, { sparse: true });
+    console.log("created index on comments.parent_id + comments.created_at (sparse)");
+
+    await commentsCollection.createIndex({ project_id: 1 }, { sparse: true });
+    console.log("created index on comments.project_id (sparse)");
+
     console.log("collections and indexes setup complete.");
   } catch (err) {
     console.error("failed to setup collections or indexes:", err);
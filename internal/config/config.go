@@ -2,28 +2,49 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/grachmannico95/mileapp-test-be/pkg/jwks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/password"
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server    ServerConfig
-	MongoDB   MongoDBConfig
-	JWT       JWTConfig
-	CSRF      CSRFConfig
-	Cookie    CookieConfig
-	RateLimit RateLimitConfig
-	CORS      CORSConfig
+	Server              ServerConfig
+	MongoDB             MongoDBConfig
+	JWT                 JWTConfig
+	CSRF                CSRFConfig
+	Password            PasswordConfig
+	Cookie              CookieConfig
+	RateLimit           RateLimitConfig
+	CORS                CORSConfig
+	Registration        RegistrationConfig
+	LoginProtection     LoginProtectionConfig
+	Mailer              MailerConfig
+	PasswordReset       PasswordResetConfig
+	EmailVerification   EmailVerificationConfig
+	MFA                 MFAConfig
+	PersonalAccessToken PersonalAccessTokenConfig
+	OIDC                OIDCConfig
+	Audit               AuditConfig
+	Impersonation       ImpersonationConfig
+	Project             ProjectConfig
+	BlobStore           BlobStoreConfig
+	Attachment          AttachmentConfig
 }
 
+// ServerConfig.TrustedProxies lists the proxy IPs or CIDR ranges whose
+// X-Forwarded-For header is believed. With none, the client IP used for rate
+// limits, lockouts and the audit log is always the connection's remote address.
 type ServerConfig struct {
-	Port       string
-	GinMode    string
-	AuthCookie bool
+	Port           string
+	GinMode        string
+	AuthCookie     bool
+	TrustedProxies []string
 }
 
 type MongoDBConfig struct {
@@ -33,12 +54,76 @@ type MongoDBConfig struct {
 }
 
 type JWTConfig struct {
-	Secret string
-	Expiry time.Duration
+	Secret             string
+	Expiry             time.Duration
+	RefreshExpiry      time.Duration
+	RevocationCacheTTL time.Duration
+	PrivateKeyFile     string
+	PublicKeyFiles     []string
+	Issuer             string
+	Audience           string
+	KeySet             *jwks.KeySet
+}
+
+// Keys returns the key set access tokens are signed and verified with. Without
+// a configured private key tokens are signed with the shared HS256 secret.
+func (c *JWTConfig) Keys() *jwks.KeySet {
+	if c.KeySet != nil {
+		return c.KeySet
+	}
+	return jwks.NewHMACKeySet(c.Secret)
 }
 
 type CSRFConfig struct {
 	Secret string
+	Expiry time.Duration
+}
+
+type PasswordConfig struct {
+	Algorithm         string
+	Argon2Memory      int
+	Argon2Iterations  int
+	Argon2Parallelism int
+	BcryptCost        int
+	MinLength         int
+	MaxLength         int
+	RequireUpper      bool
+	RequireLower      bool
+	RequireDigit      bool
+	RequireSymbol     bool
+	DisallowEmail     bool
+	BreachedListFile  string
+	BreachedList      *password.BreachedList
+}
+
+// Hasher returns the password hasher for new hashes. Hashes produced by the
+// other supported algorithm still verify and are reported as needing a rehash.
+func (c *PasswordConfig) Hasher() *password.Manager {
+	argon2id := password.NewArgon2idHasher(password.Argon2idParams{
+		Memory:      uint32(c.Argon2Memory),
+		Iterations:  uint32(c.Argon2Iterations),
+		Parallelism: uint8(c.Argon2Parallelism),
+	})
+	bcrypt := password.NewBcryptHasher(c.BcryptCost)
+
+	if c.Algorithm == "bcrypt" {
+		return password.NewManager(bcrypt, argon2id)
+	}
+	return password.NewManager(argon2id, bcrypt)
+}
+
+// Policy returns the rules new passwords are checked against.
+func (c *PasswordConfig) Policy() *password.Policy {
+	return &password.Policy{
+		MinLength:     c.MinLength,
+		MaxLength:     c.MaxLength,
+		RequireUpper:  c.RequireUpper,
+		RequireLower:  c.RequireLower,
+		RequireDigit:  c.RequireDigit,
+		RequireSymbol: c.RequireSymbol,
+		DisallowEmail: c.DisallowEmail,
+		Breached:      c.BreachedList,
+	}
 }
 
 type CookieConfig struct {
@@ -49,8 +134,62 @@ type CookieConfig struct {
 }
 
 type RateLimitConfig struct {
-	Requests int
-	Window   time.Duration
+	Enabled       bool
+	Store         string
+	Requests      int
+	Window        time.Duration
+	UserRequests  int
+	UserWindow    time.Duration
+	LoginRequests int
+	LoginWindow   time.Duration
+}
+
+type RegistrationConfig struct {
+	InviteOnly   bool
+	InviteExpiry time.Duration
+}
+
+type LoginProtectionConfig struct {
+	MaxAttempts     int
+	IPMaxAttempts   int
+	BackoffAfter    int
+	BackoffBase     time.Duration
+	LockoutDuration time.Duration
+	AttemptWindow   time.Duration
+}
+
+type MailerConfig struct {
+	Driver       string
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	LogFile      string
+}
+
+type PasswordResetConfig struct {
+	Expiry time.Duration
+	URL    string
+}
+
+type EmailVerificationConfig struct {
+	Required bool
+	Expiry   time.Duration
+	URL      string
+	Secret   string
+}
+
+type MFAConfig struct {
+	Issuer             string
+	PendingTokenExpiry time.Duration
+	RecoveryCodeCount  int
+	Secret             string
+}
+
+type PersonalAccessTokenConfig struct {
+	DefaultExpiry time.Duration
+	MaxExpiry     time.Duration
 }
 
 type CORSConfig struct {
@@ -62,14 +201,62 @@ type CORSConfig struct {
 	MaxAge           int
 }
 
+type OIDCConfig struct {
+	Providers  []OIDCProviderConfig
+	FlowExpiry time.Duration
+	Secret     string
+}
+
+type AuditConfig struct {
+	Retention time.Duration
+}
+
+type ImpersonationConfig struct {
+	Expiry time.Duration
+}
+
+// ProjectConfig.DeleteMode decides what happens to a project's tasks when the
+// project is deleted: "archive" hides them together with the project, "cascade"
+// removes both for good.
+type ProjectConfig struct {
+	DeleteMode string
+}
+
+type BlobStoreConfig struct {
+	Driver      string
+	LocalDir    string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
+type AttachmentConfig struct {
+	MaxSize      int64
+	AllowedTypes []string
+	MaxPerTask   int
+}
+
+type OIDCProviderConfig struct {
+	Name         string
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	AllowSignup  bool
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
 	config := &Config{
 		Server: ServerConfig{
-			Port:       getEnv("PORT", "8080"),
-			GinMode:    getEnv("GIN_MODE", "debug"),
-			AuthCookie: getEnvAsBool("AUTH_COOKIE", false),
+			Port:           getEnv("PORT", "8080"),
+			GinMode:        getEnv("GIN_MODE", "debug"),
+			AuthCookie:     getEnvAsBool("AUTH_COOKIE", false),
+			TrustedProxies: getEnvAsSlice("TRUSTED_PROXIES", []string{}),
 		},
 		MongoDB: MongoDBConfig{
 			URI:      getEnv("MONGODB_URI", "mongodb://localhost:27017"),
@@ -77,11 +264,33 @@ func Load() (*Config, error) {
 			Timeout:  time.Duration(getEnvAsInt("MONGODB_TIMEOUT", 10)) * time.Second,
 		},
 		JWT: JWTConfig{
-			Secret: getEnv("JWT_SECRET", ""),
-			Expiry: time.Duration(getEnvAsInt("JWT_EXPIRY_MINUTES", 15)) * time.Minute,
+			Secret:             getEnv("JWT_SECRET", ""),
+			Expiry:             time.Duration(getEnvAsInt("JWT_EXPIRY_MINUTES", 15)) * time.Minute,
+			RefreshExpiry:      time.Duration(getEnvAsInt("JWT_REFRESH_EXPIRY_HOURS", 168)) * time.Hour,
+			RevocationCacheTTL: time.Duration(getEnvAsInt("JWT_REVOCATION_CACHE_SECONDS", 30)) * time.Second,
+			PrivateKeyFile:     getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			PublicKeyFiles:     getEnvAsSlice("JWT_PUBLIC_KEY_FILES", []string{}),
+			Issuer:             getEnv("JWT_ISSUER", ""),
+			Audience:           getEnv("JWT_AUDIENCE", ""),
 		},
 		CSRF: CSRFConfig{
 			Secret: getEnv("CSRF_SECRET", ""),
+			Expiry: time.Duration(getEnvAsInt("CSRF_TOKEN_EXPIRY_MINUTES", 60)) * time.Minute,
+		},
+		Password: PasswordConfig{
+			Algorithm:         getEnv("PASSWORD_HASH_ALGORITHM", "argon2id"),
+			Argon2Memory:      getEnvAsInt("PASSWORD_ARGON2_MEMORY_KB", 65536),
+			Argon2Iterations:  getEnvAsInt("PASSWORD_ARGON2_ITERATIONS", 3),
+			Argon2Parallelism: getEnvAsInt("PASSWORD_ARGON2_PARALLELISM", 2),
+			BcryptCost:        getEnvAsInt("PASSWORD_BCRYPT_COST", 12),
+			MinLength:         getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+			MaxLength:         getEnvAsInt("PASSWORD_MAX_LENGTH", 128),
+			RequireUpper:      getEnvAsBool("PASSWORD_REQUIRE_UPPERCASE", true),
+			RequireLower:      getEnvAsBool("PASSWORD_REQUIRE_LOWERCASE", true),
+			RequireDigit:      getEnvAsBool("PASSWORD_REQUIRE_DIGIT", true),
+			RequireSymbol:     getEnvAsBool("PASSWORD_REQUIRE_SYMBOL", false),
+			DisallowEmail:     getEnvAsBool("PASSWORD_DISALLOW_EMAIL", true),
+			BreachedListFile:  getEnv("PASSWORD_BREACHED_LIST_FILE", ""),
 		},
 		Cookie: CookieConfig{
 			Domain:   getEnv("COOKIE_DOMAIN", ""),
@@ -89,32 +298,172 @@ func Load() (*Config, error) {
 			HTTPOnly: getEnvAsBool("COOKIE_HTTP_ONLY", false),
 			SameSite: getEnv("COOKIE_SAME_SITE", "Strict"),
 		},
+		RateLimit: RateLimitConfig{
+			Enabled:       getEnvAsBool("RATE_LIMIT_ENABLED", true),
+			Store:         getEnv("RATE_LIMIT_STORE", "memory"),
+			Requests:      getEnvAsInt("RATE_LIMIT_REQUESTS", 300),
+			Window:        time.Duration(getEnvAsInt("RATE_LIMIT_WINDOW_SECONDS", 60)) * time.Second,
+			UserRequests:  getEnvAsInt("RATE_LIMIT_USER_REQUESTS", 120),
+			UserWindow:    time.Duration(getEnvAsInt("RATE_LIMIT_USER_WINDOW_SECONDS", 60)) * time.Second,
+			LoginRequests: getEnvAsInt("RATE_LIMIT_LOGIN_REQUESTS", 5),
+			LoginWindow:   time.Duration(getEnvAsInt("RATE_LIMIT_LOGIN_WINDOW_SECONDS", 60)) * time.Second,
+		},
 		CORS: CORSConfig{
 			AllowedOrigins:   getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:5173"}),
-			AllowedMethods:   getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
+			AllowedMethods:   getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
 			AllowedHeaders:   getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization", "X-CSRF-Token"}),
-			ExposeHeaders:    getEnvAsSlice("CORS_EXPOSE_HEADERS", []string{}),
+			ExposeHeaders:    getEnvAsSlice("CORS_EXPOSE_HEADERS", []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After", "X-Impersonated-By"}),
 			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", true),
 			MaxAge:           getEnvAsInt("CORS_MAX_AGE", 3600),
 		},
+		LoginProtection: LoginProtectionConfig{
+			MaxAttempts:     getEnvAsInt("LOGIN_MAX_ATTEMPTS", 5),
+			IPMaxAttempts:   getEnvAsInt("LOGIN_IP_MAX_ATTEMPTS", 20),
+			BackoffAfter:    getEnvAsInt("LOGIN_BACKOFF_AFTER", 3),
+			BackoffBase:     time.Duration(getEnvAsInt("LOGIN_BACKOFF_BASE_SECONDS", 1)) * time.Second,
+			LockoutDuration: time.Duration(getEnvAsInt("LOGIN_LOCKOUT_MINUTES", 15)) * time.Minute,
+			AttemptWindow:   time.Duration(getEnvAsInt("LOGIN_ATTEMPT_WINDOW_MINUTES", 15)) * time.Minute,
+		},
+		Registration: RegistrationConfig{
+			InviteOnly:   getEnvAsBool("REGISTRATION_INVITE_ONLY", false),
+			InviteExpiry: time.Duration(getEnvAsInt("INVITE_EXPIRY_HOURS", 72)) * time.Hour,
+		},
+		Mailer: MailerConfig{
+			Driver:       getEnv("MAILER_DRIVER", "log"),
+			From:         getEnv("MAILER_FROM", "no-reply@localhost"),
+			SMTPHost:     getEnv("SMTP_HOST", ""),
+			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			LogFile:      getEnv("MAILER_LOG_FILE", ""),
+		},
+		PasswordReset: PasswordResetConfig{
+			Expiry: time.Duration(getEnvAsInt("PASSWORD_RESET_EXPIRY_MINUTES", 30)) * time.Minute,
+			URL:    getEnv("PASSWORD_RESET_URL", "http://localhost:5173/reset-password"),
+		},
+		EmailVerification: EmailVerificationConfig{
+			Required: getEnvAsBool("EMAIL_VERIFICATION_REQUIRED", false),
+			Expiry:   time.Duration(getEnvAsInt("EMAIL_VERIFICATION_EXPIRY_HOURS", 24)) * time.Hour,
+			URL:      getEnv("EMAIL_VERIFICATION_URL", "http://localhost:5173/verify-email"),
+			Secret:   getEnv("EMAIL_VERIFICATION_SECRET", ""),
+		},
+		MFA: MFAConfig{
+			Issuer:             getEnv("MFA_ISSUER", "MileApp"),
+			PendingTokenExpiry: time.Duration(getEnvAsInt("MFA_PENDING_TOKEN_MINUTES", 5)) * time.Minute,
+			RecoveryCodeCount:  getEnvAsInt("MFA_RECOVERY_CODES", 10),
+			Secret:             getEnv("MFA_SECRET", ""),
+		},
+		PersonalAccessToken: PersonalAccessTokenConfig{
+			DefaultExpiry: time.Duration(getEnvAsInt("PAT_DEFAULT_EXPIRY_DAYS", 90)) * 24 * time.Hour,
+			MaxExpiry:     time.Duration(getEnvAsInt("PAT_MAX_EXPIRY_DAYS", 365)) * 24 * time.Hour,
+		},
+		OIDC: OIDCConfig{
+			Providers:  loadOIDCProviders(getEnvAsSlice("OIDC_PROVIDERS", []string{})),
+			FlowExpiry: time.Duration(getEnvAsInt("OIDC_FLOW_EXPIRY_MINUTES", 10)) * time.Minute,
+			Secret:     getEnv("OIDC_SECRET", ""),
+		},
+		Audit: AuditConfig{
+			Retention: time.Duration(getEnvAsInt("AUDIT_RETENTION_DAYS", 90)) * 24 * time.Hour,
+		},
+		Impersonation: ImpersonationConfig{
+			Expiry: time.Duration(getEnvAsInt("IMPERSONATION_TOKEN_EXPIRY_MINUTES", 15)) * time.Minute,
+		},
+		Project: ProjectConfig{
+			DeleteMode: getEnv("PROJECT_DELETE_MODE", "archive"),
+		},
+		BlobStore: BlobStoreConfig{
+			Driver:      getEnv("BLOB_STORE_DRIVER", "local"),
+			LocalDir:    getEnv("BLOB_STORE_LOCAL_DIR", "./data/blobs"),
+			S3Endpoint:  getEnv("S3_ENDPOINT", "http://localhost:9000"),
+			S3Region:    getEnv("S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("S3_BUCKET", ""),
+			S3AccessKey: getEnv("S3_ACCESS_KEY_ID", ""),
+			S3SecretKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+		},
+		Attachment: AttachmentConfig{
+			MaxSize:      int64(getEnvAsInt("ATTACHMENT_MAX_SIZE_MB", 10)) << 20,
+			AllowedTypes: getEnvAsSlice("ATTACHMENT_ALLOWED_TYPES", []string{"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf"}),
+			MaxPerTask:   getEnvAsInt("ATTACHMENT_MAX_PER_TASK", 20),
+		},
+	}
+
+	// verification links and mfa pending tokens are signed with the JWT secret
+	// unless a dedicated one is set
+	if config.EmailVerification.Secret == "" {
+		config.EmailVerification.Secret = config.JWT.Secret
+	}
+	if config.MFA.Secret == "" {
+		config.MFA.Secret = config.JWT.Secret
+	}
+	if config.OIDC.Secret == "" {
+		config.OIDC.Secret = config.JWT.Secret
 	}
 
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
 
+	if config.JWT.PrivateKeyFile != "" {
+		keySet, err := jwks.LoadKeySet(config.JWT.PrivateKeyFile, config.JWT.PublicKeyFiles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT keys: %w", err)
+		}
+		config.JWT.KeySet = keySet
+	}
+
+	if config.Password.BreachedListFile != "" {
+		breachedList, err := password.LoadBreachedList(config.Password.BreachedListFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load breached password list: %w", err)
+		}
+		config.Password.BreachedList = breachedList
+	}
+
 	return config, nil
 }
 
 func (c *Config) Validate() error {
-	if c.JWT.Secret == "" {
-		return fmt.Errorf("JWT_SECRET is required")
+	if c.JWT.Secret == "" && c.JWT.PrivateKeyFile == "" {
+		return fmt.Errorf("JWT_SECRET or JWT_PRIVATE_KEY_FILE is required")
+	}
+
+	if c.JWT.Secret == "" && (c.EmailVerification.Secret == "" || c.MFA.Secret == "") {
+		return fmt.Errorf("EMAIL_VERIFICATION_SECRET and MFA_SECRET are required when JWT_SECRET is not set")
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("TRUSTED_PROXIES entries must be IP addresses or CIDR ranges")
+			}
+		}
 	}
 
 	if c.CSRF.Secret == "" {
 		return fmt.Errorf("CSRF_SECRET is required")
 	}
 
+	if c.Password.Algorithm != "argon2id" && c.Password.Algorithm != "bcrypt" {
+		return fmt.Errorf("PASSWORD_HASH_ALGORITHM must be one of: argon2id, bcrypt")
+	}
+
+	if c.Password.Argon2Memory < 8*c.Password.Argon2Parallelism || c.Password.Argon2Iterations < 1 || c.Password.Argon2Parallelism < 1 || c.Password.Argon2Parallelism > 255 {
+		return fmt.Errorf("PASSWORD_ARGON2_ITERATIONS and PASSWORD_ARGON2_PARALLELISM must be positive and PASSWORD_ARGON2_MEMORY_KB at least 8 per lane")
+	}
+
+	if c.Password.BcryptCost < 10 || c.Password.BcryptCost > 31 {
+		return fmt.Errorf("PASSWORD_BCRYPT_COST must be between 10 and 31")
+	}
+
+	if c.Password.MinLength < 1 || c.Password.MaxLength < c.Password.MinLength {
+		return fmt.Errorf("PASSWORD_MIN_LENGTH must be positive and not exceed PASSWORD_MAX_LENGTH")
+	}
+
+	// bcrypt ignores everything after 72 bytes
+	if c.Password.Algorithm == "bcrypt" && c.Password.MaxLength > 72 {
+		return fmt.Errorf("PASSWORD_MAX_LENGTH must not exceed 72 when PASSWORD_HASH_ALGORITHM is bcrypt")
+	}
+
 	if c.MongoDB.URI == "" {
 		return fmt.Errorf("MONGODB_URI is required")
 	}
@@ -123,11 +472,115 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("MONGODB_DATABASE is required")
 	}
 
+	if c.RateLimit.Store != "memory" && c.RateLimit.Store != "mongo" {
+		return fmt.Errorf("RATE_LIMIT_STORE must be one of: memory, mongo")
+	}
+
+	if c.Mailer.Driver != "log" && c.Mailer.Driver != "smtp" {
+		return fmt.Errorf("MAILER_DRIVER must be one of: log, smtp")
+	}
+
+	if c.Mailer.Driver == "smtp" && c.Mailer.SMTPHost == "" {
+		return fmt.Errorf("SMTP_HOST is required when MAILER_DRIVER is smtp")
+	}
+
+	if c.Audit.Retention < 0 {
+		return fmt.Errorf("AUDIT_RETENTION_DAYS must not be negative")
+	}
+
+	if c.Impersonation.Expiry <= 0 {
+		return fmt.Errorf("IMPERSONATION_TOKEN_EXPIRY_MINUTES must be positive")
+	}
+
+	if c.Project.DeleteMode != "archive" && c.Project.DeleteMode != "cascade" {
+		return fmt.Errorf("PROJECT_DELETE_MODE must be one of: archive, cascade")
+	}
+
+	if c.BlobStore.Driver != "local" && c.BlobStore.Driver != "s3" {
+		return fmt.Errorf("BLOB_STORE_DRIVER must be one of: local, s3")
+	}
+
+	if c.BlobStore.Driver == "local" && c.BlobStore.LocalDir == "" {
+		return fmt.Errorf("BLOB_STORE_LOCAL_DIR is required when BLOB_STORE_DRIVER is local")
+	}
+
+	if c.BlobStore.Driver == "s3" && (c.BlobStore.S3Endpoint == "" || c.BlobStore.S3Region == "" || c.BlobStore.S3Bucket == "" || c.BlobStore.S3AccessKey == "" || c.BlobStore.S3SecretKey == "") {
+		return fmt.Errorf("S3_ENDPOINT, S3_REGION, S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required when BLOB_STORE_DRIVER is s3")
+	}
+
+	if c.Attachment.MaxSize <= 0 {
+		return fmt.Errorf("ATTACHMENT_MAX_SIZE_MB must be positive")
+	}
+
+	if len(c.Attachment.AllowedTypes) == 0 {
+		return fmt.Errorf("ATTACHMENT_ALLOWED_TYPES must list at least one type")
+	}
+
+	if c.Attachment.MaxPerTask < 1 {
+		return fmt.Errorf("ATTACHMENT_MAX_PER_TASK must be positive")
+	}
+
+	seenProviders := map[string]bool{}
+	for _, provider := range c.OIDC.Providers {
+		prefix := "OIDC_" + strings.ToUpper(provider.Name)
+		if !isValidProviderName(provider.Name) {
+			return fmt.Errorf("OIDC_PROVIDERS entries may only contain lowercase letters, digits and underscores")
+		}
+		if seenProviders[provider.Name] {
+			return fmt.Errorf("OIDC provider %s is configured twice", provider.Name)
+		}
+		seenProviders[provider.Name] = true
+
+		if provider.IssuerURL == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return fmt.Errorf("%s_ISSUER_URL, %s_CLIENT_ID and %s_REDIRECT_URL are required", prefix, prefix, prefix)
+		}
+	}
+
+	if len(c.OIDC.Providers) > 0 && c.OIDC.Secret == "" {
+		return fmt.Errorf("OIDC_SECRET is required when JWT_SECRET is not set")
+	}
+
 	return nil
 }
 
 // Helper functions
 
+// loadOIDCProviders reads OIDC_<NAME>_* variables for every provider listed in
+// OIDC_PROVIDERS.
+func loadOIDCProviders(names []string) []OIDCProviderConfig {
+	providers := make([]OIDCProviderConfig, 0, len(names))
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			IssuerURL:    getEnv(prefix+"ISSUER_URL", ""),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       getEnvAsSlice(prefix+"SCOPES", []string{"openid", "email", "profile"}),
+			AllowSignup:  getEnvAsBool(prefix+"ALLOW_SIGNUP", false),
+		})
+	}
+
+	return providers
+}
+
+func isValidProviderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/grachmannico95/mileapp-test-be/internal/model"
+
+type AttachmentResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+	Checksum    string `json:"checksum"`
+	UploadedBy  string `json:"uploaded_by"`
+	UploadedAt  string `json:"uploaded_at"`
+}
+
+func ToAttachmentResponse(attachment *model.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          attachment.ID.Hex(),
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		Checksum:    attachment.Checksum,
+		UploadedBy:  attachment.UploadedBy.Hex(),
+		UploadedAt:  attachment.UploadedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
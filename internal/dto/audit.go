@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type AuditLogQueryParams struct {
+	Page           int    `form:"page" binding:"omitempty,min=1"`
+	Limit          int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	ActorID        string `form:"actor_id"`
+	ImpersonatorID string `form:"impersonator_id"`
+	Action         string `form:"action"`
+	TargetType     string `form:"target_type"`
+	TargetID       string `form:"target_id"`
+	Outcome        string `form:"outcome" binding:"omitempty,oneof=success failure"`
+	From           string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To             string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type AuditLogResponse struct {
+	ID             string            `json:"id"`
+	ActorID        string            `json:"actor_id,omitempty"`
+	ImpersonatorID string            `json:"impersonator_id,omitempty"`
+	IP             string            `json:"ip"`
+	UserAgent      string            `json:"user_agent"`
+	Action         string            `json:"action"`
+	TargetType     string            `json:"target_type,omitempty"`
+	TargetID       string            `json:"target_id,omitempty"`
+	Outcome        string            `json:"outcome"`
+	Reason         string            `json:"reason,omitempty"`
+	Metadata       map[string]string `json:"metadata,omitempty"`
+	CreatedAt      string            `json:"created_at"`
+}
+
+type AuditLogListResponse struct {
+	Entries []AuditLogResponse `json:"entries"`
+	Meta    PaginationMeta     `json:"meta"`
+}
+
+func ToAuditLogResponse(entry *model.AuditLog) AuditLogResponse {
+	response := AuditLogResponse{
+		ID:         entry.ID.Hex(),
+		IP:         entry.IP,
+		UserAgent:  entry.UserAgent,
+		Action:     string(entry.Action),
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Outcome:    string(entry.Outcome),
+		Reason:     entry.Reason,
+		Metadata:   entry.Metadata,
+		CreatedAt:  entry.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if !entry.ActorID.IsZero() {
+		response.ActorID = entry.ActorID.Hex()
+	}
+
+	if !entry.ImpersonatorID.IsZero() {
+		response.ImpersonatorID = entry.ImpersonatorID.Hex()
+	}
+
+	return response
+}
+
+func ToAuditLogListResponse(entries []model.AuditLog, meta PaginationMeta) AuditLogListResponse {
+	responses := make([]AuditLogResponse, len(entries))
+	for i, entry := range entries {
+		responses[i] = ToAuditLogResponse(&entry)
+	}
+
+	return AuditLogListResponse{
+		Entries: responses,
+		Meta:    meta,
+	}
+}
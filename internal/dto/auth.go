@@ -6,27 +6,112 @@ import (
 
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required,min=6,max=32"`
+	Password string `json:"password" binding:"required,max=1024"`
+}
+
+type RegisterRequest struct {
+	Email      string `json:"email" binding:"required,email"`
+	Password   string `json:"password" binding:"required"`
+	InviteCode string `json:"invite_code"`
+}
+
+type CreateInviteRequest struct {
+	Email string `json:"email" binding:"omitempty,email"`
+}
+
+type InviteResponse struct {
+	Code      string `json:"code"`
+	Email     string `json:"email,omitempty"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// AuthTokens is the set of credentials handed to a client after a successful
+// login or refresh.
+type AuthTokens struct {
+	AccessToken  string
+	CSRFToken    string
+	RefreshToken string
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type MFAChallengeResponse struct {
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token"`
+	ExpiresIn   int    `json:"expires_in"`
 }
 
 type LoginResponse struct {
-	User        *UserResponse `json:"user"`
-	AccessToken string        `json:"access_token,omitempty"`
-	CSRFToken   string        `json:"csrf_token,omitempty"`
+	User         *UserResponse `json:"user"`
+	AccessToken  string        `json:"access_token,omitempty"`
+	CSRFToken    string        `json:"csrf_token,omitempty"`
+	RefreshToken string        `json:"refresh_token,omitempty"`
+}
+
+type CSRFTokenResponse struct {
+	CSRFToken string `json:"csrf_token,omitempty"`
 }
 
 type UserResponse struct {
-	ID        string `json:"id"`
-	Email     string `json:"email"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	Role          string `json:"role"`
+	DisplayName   string `json:"display_name"`
+	Timezone      string `json:"timezone"`
+	Locale        string `json:"locale"`
+	EmailVerified bool   `json:"email_verified"`
+	MFAEnabled    bool   `json:"mfa_enabled"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
 }
 
 func ToUserResponse(user *model.User) *UserResponse {
 	return &UserResponse{
-		ID:        user.ID.Hex(),
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:            user.ID.Hex(),
+		Email:         user.Email,
+		Role:          string(user.GetRole()),
+		DisplayName:   user.DisplayName,
+		Timezone:      user.Timezone,
+		Locale:        user.Locale,
+		EmailVerified: user.IsEmailVerified(),
+		MFAEnabled:    user.MFA.Enabled,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func ToInviteResponse(invite *model.Invite) *InviteResponse {
+	return &InviteResponse{
+		Code:      invite.Code,
+		Email:     invite.Email,
+		ExpiresAt: invite.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
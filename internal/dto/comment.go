@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type CreateCommentRequest struct {
+	ParentID string `json:"parent_id"`
+	Body     string `json:"body" binding:"required,min=1,max=5000"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,min=1,max=5000"`
+}
+
+type CommentQueryParams struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type CommentResponse struct {
+	ID        string            `json:"id"`
+	TaskID    string            `json:"task_id"`
+	ParentID  string            `json:"parent_id,omitempty"`
+	AuthorID  string            `json:"author_id"`
+	Body      string            `json:"body"`
+	Edited    bool              `json:"edited"`
+	EditedAt  *time.Time        `json:"edited_at,omitempty"`
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+	Replies   []CommentResponse `json:"replies,omitempty"`
+}
+
+type CommentListResponse struct {
+	Comments []CommentResponse `json:"comments"`
+	Meta     PaginationMeta    `json:"meta"`
+}
+
+func ToCommentResponse(comment *model.Comment) CommentResponse {
+	response := CommentResponse{
+		ID:        comment.ID.Hex(),
+		TaskID:    comment.TaskID.Hex(),
+		AuthorID:  comment.AuthorID.Hex(),
+		Body:      comment.Body,
+		Edited:    comment.Edited,
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: comment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if comment.ParentID != nil {
+		response.ParentID = comment.ParentID.Hex()
+	}
+
+	return response
+}
+
+// ToCommentListResponse nests each reply under its top-level comment.
+func ToCommentListResponse(comments []model.Comment, replies []model.Comment, meta PaginationMeta) CommentListResponse {
+	repliesByParent := make(map[string][]CommentResponse)
+	for _, reply := range replies {
+		parentID := reply.ParentID.Hex()
+		repliesByParent[parentID] = append(repliesByParent[parentID], ToCommentResponse(&reply))
+	}
+
+	responses := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = ToCommentResponse(&comment)
+		responses[i].Replies = repliesByParent[responses[i].ID]
+	}
+
+	return CommentListResponse{
+		Comments: responses,
+		Meta:     meta,
+	}
+}
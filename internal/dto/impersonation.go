@@ -0,0 +1,13 @@
+package dto
+
+type StartImpersonationRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+type ImpersonationResponse struct {
+	User        *UserResponse `json:"user"`
+	AccessToken string        `json:"access_token,omitempty"`
+	CSRFToken   string        `json:"csrf_token,omitempty"`
+	ExpiresIn   int           `json:"expires_in"`
+}
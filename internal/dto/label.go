@@ -0,0 +1,48 @@
+package dto
+
+import "github.com/grachmannico95/mileapp-test-be/internal/model"
+
+// Label names are used as comma separated values in the tags filter, so they
+// must not contain commas.
+type CreateLabelRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=50,excludesall=0x2C"`
+	Color string `json:"color" binding:"required,hexcolor"`
+}
+
+type UpdateLabelRequest struct {
+	Name  *string `json:"name" binding:"omitempty,min=1,max=50,excludesall=0x2C"`
+	Color *string `json:"color" binding:"omitempty,hexcolor"`
+}
+
+type LabelResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Color     string `json:"color"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type LabelListResponse struct {
+	Labels []LabelResponse `json:"labels"`
+}
+
+func ToLabelResponse(label *model.Label) LabelResponse {
+	return LabelResponse{
+		ID:        label.ID.Hex(),
+		Name:      label.Name,
+		Color:     label.Color,
+		CreatedAt: label.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: label.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func ToLabelListResponse(labels []model.Label) LabelListResponse {
+	responses := make([]LabelResponse, len(labels))
+	for i, label := range labels {
+		responses[i] = ToLabelResponse(&label)
+	}
+
+	return LabelListResponse{
+		Labels: responses,
+	}
+}
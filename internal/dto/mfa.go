@@ -0,0 +1,22 @@
+package dto
+
+type MFAEnrollResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type MFAConfirmRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type MFAConfirmResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFADisableRequest carries the account password, or a TOTP or recovery code
+// for accounts that have no password because they sign in through an identity
+// provider.
+type MFADisableRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,min=1,max=100"`
+	Scopes        []string `json:"scopes" binding:"required,min=1,dive,required"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"omitempty,min=1"`
+}
+
+type PersonalAccessTokenResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	ExpiresAt  string     `json:"expires_at"`
+	CreatedAt  string     `json:"created_at"`
+}
+
+// CreatedPersonalAccessTokenResponse is only returned once, right after
+// creation, because the token itself is not stored.
+type CreatedPersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
+
+func ToPersonalAccessTokenResponse(token *model.PersonalAccessToken) PersonalAccessTokenResponse {
+	return PersonalAccessTokenResponse{
+		ID:         token.ID.Hex(),
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.Scopes,
+		LastUsedAt: token.LastUsedAt,
+		LastUsedIP: token.LastUsedIP,
+		RevokedAt:  token.RevokedAt,
+		ExpiresAt:  token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:  token.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func ToPersonalAccessTokenListResponse(tokens []model.PersonalAccessToken) []PersonalAccessTokenResponse {
+	responses := make([]PersonalAccessTokenResponse, len(tokens))
+	for i, token := range tokens {
+		responses[i] = ToPersonalAccessTokenResponse(&token)
+	}
+	return responses
+}
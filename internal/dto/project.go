@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CreateProjectRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=100"`
+	Description string `json:"description" binding:"max=2000"`
+}
+
+type UpdateProjectRequest struct {
+	Name        *string `json:"name" binding:"omitempty,min=1,max=100"`
+	Description *string `json:"description" binding:"omitempty,max=2000"`
+}
+
+type AddProjectMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,oneof=owner editor viewer"`
+}
+
+type UpdateProjectMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner editor viewer"`
+}
+
+type ProjectMemberResponse struct {
+	UserID  string `json:"user_id"`
+	Role    string `json:"role"`
+	AddedAt string `json:"added_at"`
+}
+
+type ProjectResponse struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	Role        string                  `json:"role"`
+	Members     []ProjectMemberResponse `json:"members"`
+	CreatedAt   string                  `json:"created_at"`
+	UpdatedAt   string                  `json:"updated_at"`
+}
+
+type ProjectListResponse struct {
+	Projects []ProjectResponse `json:"projects"`
+}
+
+// ToProjectResponse includes the role userID holds in the project, so clients
+// know which actions to offer.
+func ToProjectResponse(project *model.Project, userID string) ProjectResponse {
+	members := make([]ProjectMemberResponse, len(project.Members))
+	for i, member := range project.Members {
+		members[i] = ProjectMemberResponse{
+			UserID:  member.UserID.Hex(),
+			Role:    string(member.Role),
+			AddedAt: member.AddedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	objectID, _ := primitive.ObjectIDFromHex(userID)
+	role, _ := project.MemberRole(objectID)
+
+	return ProjectResponse{
+		ID:          project.ID.Hex(),
+		Name:        project.Name,
+		Description: project.Description,
+		Role:        string(role),
+		Members:     members,
+		CreatedAt:   project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func ToProjectListResponse(projects []model.Project, userID string) ProjectListResponse {
+	responses := make([]ProjectResponse, len(projects))
+	for i, project := range projects {
+		responses[i] = ToProjectResponse(&project, userID)
+	}
+
+	return ProjectListResponse{
+		Projects: responses,
+	}
+}
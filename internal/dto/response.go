@@ -3,12 +3,14 @@ package dto
 type APIResponse struct {
 	Success bool        `json:"success"`
 	Message string      `json:"message"`
+	Code    string      `json:"code,omitempty"`
 	Data    interface{} `json:"data,omitempty"`
 	Errors  []ErrorItem `json:"errors,omitempty"`
 }
 
 type ErrorItem struct {
 	Field   string `json:"field,omitempty"`
+	Code    string `json:"code,omitempty"`
 	Message string `json:"message"`
 }
 
@@ -34,3 +36,12 @@ func ErrorResponse(message string, errors ...ErrorItem) APIResponse {
 		Errors:  errors,
 	}
 }
+
+func ErrorResponseWithCode(code, message string, errors ...ErrorItem) APIResponse {
+	return APIResponse{
+		Success: false,
+		Message: message,
+		Code:    code,
+		Errors:  errors,
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type SessionResponse struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	Current    bool   `json:"current"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+	CreatedAt  string `json:"created_at"`
+}
+
+func ToSessionResponse(session *model.Session, currentSessionID string) SessionResponse {
+	return SessionResponse{
+		ID:         session.ID.Hex(),
+		UserAgent:  session.UserAgent,
+		IP:         session.IP,
+		Current:    session.ID.Hex() == currentSessionID,
+		LastSeenAt: session.LastSeenAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:  session.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:  session.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func ToSessionListResponse(sessions []model.Session, currentSessionID string) []SessionResponse {
+	responses := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = ToSessionResponse(&session, currentSessionID)
+	}
+	return responses
+}
@@ -7,6 +7,9 @@ import (
 )
 
 type CreateTaskRequest struct {
+	ProjectID   string    `json:"project_id"`
+	AssigneeIDs []string  `json:"assignee_ids" binding:"omitempty,max=10"`
+	Tags        []string  `json:"tags" binding:"omitempty,max=20"`
 	Title       string    `json:"title" binding:"required,min=3,max=200"`
 	Description string    `json:"description" binding:"max=2000"`
 	Status      string    `json:"status" binding:"omitempty,oneof=pending in_progress completed"`
@@ -14,35 +17,59 @@ type CreateTaskRequest struct {
 	DueDate     *JSONTime `json:"due_date"`
 }
 
+// UpdateTaskRequest leaves the assignees and tags untouched when AssigneeIDs
+// or Tags is omitted; an empty list removes all of them.
 type UpdateTaskRequest struct {
 	Title       string    `json:"title" binding:"required,min=3,max=200"`
 	Description string    `json:"description" binding:"max=2000"`
 	Status      string    `json:"status" binding:"omitempty,oneof=pending in_progress completed"`
 	Priority    string    `json:"priority" binding:"omitempty,oneof=low medium high"`
 	DueDate     *JSONTime `json:"due_date"`
+	AssigneeIDs *[]string `json:"assignee_ids" binding:"omitempty,max=10"`
+	Tags        *[]string `json:"tags" binding:"omitempty,max=20"`
 }
 
+// TaskQueryParams lists the caller's own tasks, or all tasks of a project when
+// ProjectID is set. AssignedToMe without a project lists the tasks assigned to
+// the caller wherever they live. Tags is a comma separated list of label names
+// matched with any-of semantics unless TagsMatch is "all".
 type TaskQueryParams struct {
-	Page        int    `form:"page" binding:"omitempty,min=1"`
-	Limit       int    `form:"limit" binding:"omitempty,min=1,max=100"`
-	Status      string `form:"status" binding:"omitempty,oneof=pending in_progress completed"`
-	Priority    string `form:"priority" binding:"omitempty,oneof=low medium high"`
-	Search      string `form:"search"`
-	DueDateFrom string `form:"due_date_from"`
-	DueDateTo   string `form:"due_date_to"`
-	SortBy      string `form:"sort_by" binding:"omitempty,oneof=created_at updated_at due_date priority title"`
-	SortOrder   string `form:"sort_order" binding:"omitempty,oneof=asc desc"`
+	ProjectID    string `form:"project_id"`
+	Assignee     string `form:"assignee"`
+	AssignedToMe bool   `form:"assigned_to_me"`
+	Tags         string `form:"tags"`
+	TagsMatch    string `form:"tags_match" binding:"omitempty,oneof=any all"`
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	Limit        int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Status       string `form:"status" binding:"omitempty,oneof=pending in_progress completed"`
+	Priority     string `form:"priority" binding:"omitempty,oneof=low medium high"`
+	Search       string `form:"search"`
+	DueDateFrom  string `form:"due_date_from"`
+	DueDateTo    string `form:"due_date_to"`
+	SortBy       string `form:"sort_by" binding:"omitempty,oneof=created_at updated_at due_date priority title"`
+	SortOrder    string `form:"sort_order" binding:"omitempty,oneof=asc desc"`
+}
+
+type TaskAssigneeResponse struct {
+	ID          string `json:"id"`
+	Email       string `json:"email,omitempty"`
+	DisplayName string `json:"display_name"`
 }
 
 type TaskResponse struct {
-	ID          string     `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Status      string     `json:"status"`
-	Priority    string     `json:"priority"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	CreatedAt   string     `json:"created_at"`
-	UpdatedAt   string     `json:"updated_at"`
+	ID           string                 `json:"id"`
+	ProjectID    string                 `json:"project_id,omitempty"`
+	Assignees    []TaskAssigneeResponse `json:"assignees"`
+	Tags         []string               `json:"tags"`
+	CommentCount int64                  `json:"comment_count"`
+	Attachments  []AttachmentResponse   `json:"attachments"`
+	Title        string                 `json:"title"`
+	Description  string                 `json:"description"`
+	Status       string                 `json:"status"`
+	Priority     string                 `json:"priority"`
+	DueDate      *time.Time             `json:"due_date,omitempty"`
+	CreatedAt    string                 `json:"created_at"`
+	UpdatedAt    string                 `json:"updated_at"`
 }
 
 type TaskListResponse struct {
@@ -74,16 +101,42 @@ func (jt *JSONTime) UnmarshalJSON(b []byte) error {
 }
 
 func ToTaskResponse(task *model.Task) TaskResponse {
-	return TaskResponse{
-		ID:          task.ID.Hex(),
-		Title:       task.Title,
-		Description: task.Description,
-		Status:      string(task.Status),
-		Priority:    model.PriorityIntToString(task.Priority),
-		DueDate:     task.DueDate,
-		CreatedAt:   task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	response := TaskResponse{
+		ID:           task.ID.Hex(),
+		Title:        task.Title,
+		Description:  task.Description,
+		Status:       string(task.Status),
+		Priority:     model.PriorityIntToString(task.Priority),
+		DueDate:      task.DueDate,
+		CreatedAt:    task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		CommentCount: task.CommentCount,
+	}
+
+	if task.ProjectID != nil {
+		response.ProjectID = task.ProjectID.Hex()
+	}
+
+	response.Tags = task.Tags
+	if response.Tags == nil {
+		response.Tags = []string{}
 	}
+
+	response.Assignees = make([]TaskAssigneeResponse, len(task.Assignees))
+	for i, assignee := range task.Assignees {
+		response.Assignees[i] = TaskAssigneeResponse{
+			ID:          assignee.ID.Hex(),
+			Email:       assignee.Email,
+			DisplayName: assignee.DisplayName,
+		}
+	}
+
+	response.Attachments = make([]AttachmentResponse, len(task.Attachments))
+	for i, attachment := range task.Attachments {
+		response.Attachments[i] = ToAttachmentResponse(&attachment)
+	}
+
+	return response
 }
 
 func ToTaskListResponse(tasks []model.Task, meta PaginationMeta) TaskListResponse {
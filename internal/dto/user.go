@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type UserQueryParams struct {
+	Page   int    `form:"page" binding:"omitempty,min=1"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Role   string `form:"role" binding:"omitempty,oneof=admin member viewer"`
+	Search string `form:"search"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=admin member viewer"`
+}
+
+// UpdateProfileRequest only touches the fields that are present; sending an
+// empty string clears the field.
+type UpdateProfileRequest struct {
+	DisplayName *string `json:"display_name" binding:"omitempty,max=100"`
+	Timezone    *string `json:"timezone" binding:"omitempty,len=0|timezone"`
+	Locale      *string `json:"locale" binding:"omitempty,len=0|bcp47_language_tag"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+type UserListResponse struct {
+	Users []UserResponse `json:"users"`
+	Meta  PaginationMeta `json:"meta"`
+}
+
+func ToUserListResponse(users []model.User, meta PaginationMeta) UserListResponse {
+	userResponses := make([]UserResponse, len(users))
+	for i, user := range users {
+		userResponses[i] = *ToUserResponse(&user)
+	}
+
+	return UserListResponse{
+		Users: userResponses,
+		Meta:  meta,
+	}
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+)
+
+// multipartOverhead is the room left for the multipart framing on top of the
+// largest allowed file.
+const multipartOverhead = 1 << 20
+
+type AttachmentHandler struct {
+	attachmentService service.AttachmentService
+	config            *config.Config
+}
+
+func NewAttachmentHandler(attachmentService service.AttachmentService, config *config.Config) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+		config:            config,
+	}
+}
+
+// Upload expects a multipart form with the file in the "file" field.
+func (h *AttachmentHandler) Upload(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.config.Attachment.MaxSize+multipartOverhead)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse("file too large"))
+			return
+		}
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("file is required"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentService.Upload(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		respondAttachmentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("attachment uploaded successfully", dto.ToAttachmentResponse(attachment)))
+}
+
+// Download streams the file. http.ServeContent answers range and conditional
+// requests, the checksum doubles as the ETag.
+func (h *AttachmentHandler) Download(c *gin.Context) {
+	attachment, blob, err := h.attachmentService.Open(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), c.Param("attachment_id"))
+	if err != nil {
+		respondAttachmentError(c, err)
+		return
+	}
+	defer blob.Close()
+
+	c.Header("Content-Type", attachment.ContentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	c.Header("ETag", `"`+attachment.Checksum+`"`)
+
+	http.ServeContent(c.Writer, c.Request, attachment.Name, attachment.UploadedAt, blob)
+}
+
+func (h *AttachmentHandler) Delete(c *gin.Context) {
+	if err := h.attachmentService.Delete(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), c.Param("attachment_id")); err != nil {
+		respondAttachmentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("attachment deleted successfully", nil))
+}
+
+func respondAttachmentError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "task not found", "attachment not found":
+		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+	case "insufficient project permissions", "only the task creator can change this task":
+		c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+	case "file too large":
+		c.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse(err.Error()))
+	case "file type not allowed":
+		c.JSON(http.StatusUnsupportedMediaType, dto.ErrorResponse(err.Error()))
+	case "invalid user ID", "invalid task ID", "invalid attachment ID", "too many attachments":
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+	}
+}
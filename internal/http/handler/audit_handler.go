@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type AuditHandler struct {
+	auditService service.AuditService
+}
+
+func NewAuditHandler(auditService service.AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+func (h *AuditHandler) List(c *gin.Context) {
+	var params dto.AuditLogQueryParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	entries, meta, err := h.auditService.List(c.Request.Context(), params)
+	if err != nil {
+		switch err.Error() {
+		case "invalid actor ID", "invalid impersonator ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("audit log retrieved successfully", dto.ToAuditLogListResponse(entries, meta)))
+}
@@ -1,16 +1,33 @@
 package handler
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/grachmannico95/mileapp-test-be/internal/config"
 	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"github.com/grachmannico95/mileapp-test-be/internal/service"
 	"github.com/grachmannico95/mileapp-test-be/internal/util"
 )
 
+const (
+	refreshTokenCookie     = "refresh_token"
+	refreshTokenCookiePath = "/api/v1/token/refresh"
+)
+
+var sameSiteModes = map[string]http.SameSite{
+	"SameSite": http.SameSiteDefaultMode,
+	"Lax":      http.SameSiteLaxMode,
+	"Strict":   http.SameSiteStrictMode,
+	"None":     http.SameSiteNoneMode,
+}
+
 type AuthHandler struct {
 	authService service.AuthService
 	config      *config.Config
@@ -31,42 +48,199 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	user, jwtToken, csrfToken, err := h.authService.Login(c.Request.Context(), req.Email, req.Password)
+	user, tokens, err := h.authService.Login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
+		var throttled *service.LoginThrottledError
+		if errors.As(err, &throttled) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, dto.ErrorResponseWithCode(throttled.Code, err.Error()))
+			return
+		}
+		var mfaRequired *service.MFARequiredError
+		if errors.As(err, &mfaRequired) {
+			c.JSON(http.StatusOK, dto.SuccessResponse(err.Error(), dto.MFAChallengeResponse{
+				MFARequired: true,
+				MFAToken:    mfaRequired.Token,
+				ExpiresIn:   int(mfaRequired.ExpiresIn.Seconds()),
+			}))
+			return
+		}
+		if err.Error() == "email address is not verified" {
+			c.JSON(http.StatusForbidden, dto.ErrorResponseWithCode("email_not_verified", err.Error()))
+			return
+		}
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
 		return
 	}
 
-	response := dto.LoginResponse{}
-	if h.config.Server.AuthCookie {
-		response = dto.LoginResponse{
-			User: dto.ToUserResponse(user),
+	c.JSON(http.StatusOK, dto.SuccessResponse("login successful", h.buildAuthResponse(c, user, tokens)))
+}
+
+func (h *AuthHandler) LoginMFA(c *gin.Context) {
+	var req dto.MFALoginRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, tokens, err := h.authService.CompleteMFALogin(c.Request.Context(), req.MFAToken, req.Code, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		var throttled *service.LoginThrottledError
+		if errors.As(err, &throttled) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttled.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, dto.ErrorResponseWithCode(throttled.Code, err.Error()))
+			return
 		}
-		h.setAuthCookies(c, jwtToken, csrfToken)
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("login successful", h.buildAuthResponse(c, user, tokens)))
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var refreshToken string
+
+	if h.config.Server.AuthCookie {
+		refreshToken, _ = c.Cookie(refreshTokenCookie)
 	} else {
-		response = dto.LoginResponse{
-			User:        dto.ToUserResponse(user),
-			AccessToken: jwtToken,
-			CSRFToken:   csrfToken,
+		var req dto.RefreshTokenRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+			return
 		}
+		refreshToken = req.RefreshToken
 	}
 
-	c.JSON(http.StatusOK, dto.SuccessResponse("login successful", response))
+	user, tokens, err := h.authService.Refresh(c.Request.Context(), refreshToken, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		if h.config.Server.AuthCookie {
+			h.clearAuthCookies(c)
+		}
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("token refreshed successfully", h.buildAuthResponse(c, user, tokens)))
+}
+
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req dto.RegisterRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, err := h.authService.Register(c.Request.Context(), req.Email, req.Password, req.InviteCode)
+	if err != nil {
+		switch err.Error() {
+		case "email already exists":
+			c.JSON(http.StatusConflict, dto.ErrorResponse(err.Error()))
+		case "invite code is required", "invalid or expired invite code":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		case "password does not meet the policy requirements":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("registration successful", dto.ToUserResponse(user)))
+}
+
+func (h *AuthHandler) CreateInvite(c *gin.Context) {
+	var req dto.CreateInviteRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	invite, err := h.authService.CreateInvite(c.Request.Context(), middleware.GetUserID(c), req.Email)
+	if err != nil {
+		switch err.Error() {
+		case "insufficient permissions":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		case "invalid user ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("invite created successfully", dto.ToInviteResponse(invite)))
 }
 
 func (h *AuthHandler) Logout(c *gin.Context) {
+	var req dto.LogoutRequest
+
+	// the body is optional; only header mode clients can hand over their refresh token
+	if !h.config.Server.AuthCookie && c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+			return
+		}
+	}
+
+	if err := h.authService.Logout(c.Request.Context(), middleware.GetClaims(c), req.RefreshToken); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
 	h.clearAuthCookies(c)
 	c.JSON(http.StatusOK, dto.SuccessResponse("logout successful", nil))
 }
 
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	if err := h.authService.LogoutAll(c.Request.Context(), middleware.GetUserID(c)); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	h.clearAuthCookies(c)
+	c.JSON(http.StatusOK, dto.SuccessResponse("logged out from all devices", nil))
+}
+
+// CSRFToken hands out a fresh CSRF token for the current session, for clients
+// whose token expired before their access token.
+func (h *AuthHandler) CSRFToken(c *gin.Context) {
+	csrfToken := h.authService.IssueCSRFToken(middleware.GetClaims(c))
+
+	if h.config.Server.AuthCookie {
+		h.setCSRFCookie(c, csrfToken)
+		c.JSON(http.StatusOK, dto.SuccessResponse("csrf token issued", dto.CSRFTokenResponse{}))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("csrf token issued", dto.CSRFTokenResponse{CSRFToken: csrfToken}))
+}
+
+// buildAuthResponse either sets the tokens as cookies or returns them in the
+// body, depending on the configured auth mode.
+func (h *AuthHandler) buildAuthResponse(c *gin.Context, user *model.User, tokens *dto.AuthTokens) dto.LoginResponse {
+	if h.config.Server.AuthCookie {
+		h.setAuthCookies(c, tokens.AccessToken, tokens.CSRFToken)
+		h.setRefreshCookie(c, tokens.RefreshToken)
+		return dto.LoginResponse{
+			User: dto.ToUserResponse(user),
+		}
+	}
+
+	return dto.LoginResponse{
+		User:         dto.ToUserResponse(user),
+		AccessToken:  tokens.AccessToken,
+		CSRFToken:    tokens.CSRFToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
 func (h *AuthHandler) setAuthCookies(c *gin.Context, jwtToken, csrfToken string) {
 	maxAge := time.Now().Add(h.config.JWT.Expiry)
-	sameSite := map[string]http.SameSite{
-		"SameSite": http.SameSiteDefaultMode,
-		"Lax":      http.SameSiteLaxMode,
-		"Strict":   http.SameSiteStrictMode,
-		"None":     http.SameSiteNoneMode,
-	}
+	sameSite := sameSiteModes
 
 	accessTokenCookie := &http.Cookie{
 		Name:     "access_token",
@@ -79,22 +253,52 @@ func (h *AuthHandler) setAuthCookies(c *gin.Context, jwtToken, csrfToken string)
 		SameSite: sameSite[h.config.Cookie.SameSite],
 	}
 
+	http.SetCookie(c.Writer, accessTokenCookie)
+	h.setCSRFCookie(c, csrfToken)
+}
+
+// setCSRFCookie stores the CSRF token in a cookie readable by the frontend so
+// it can echo it back in the X-CSRF-Token header.
+func (h *AuthHandler) setCSRFCookie(c *gin.Context, csrfToken string) {
 	csrfTokenCookie := &http.Cookie{
 		Name:     "csrf_token",
 		Value:    csrfToken,
 		Path:     "/",
 		Domain:   h.config.Cookie.Domain,
-		Expires:  maxAge,
+		Expires:  time.Now().Add(h.config.CSRF.Expiry),
 		HttpOnly: false,
 		Secure:   h.config.Cookie.Secure,
-		SameSite: sameSite[h.config.Cookie.SameSite],
+		SameSite: sameSiteModes[h.config.Cookie.SameSite],
 	}
 
-	http.SetCookie(c.Writer, accessTokenCookie)
 	http.SetCookie(c.Writer, csrfTokenCookie)
 }
 
+// setRefreshCookie stores the refresh token in an HttpOnly cookie that the
+// browser only sends to the refresh endpoint.
+func (h *AuthHandler) setRefreshCookie(c *gin.Context, refreshToken string) {
+	refreshCookie := &http.Cookie{
+		Name:     refreshTokenCookie,
+		Value:    refreshToken,
+		Path:     refreshTokenCookiePath,
+		Domain:   h.config.Cookie.Domain,
+		Expires:  time.Now().Add(h.config.JWT.RefreshExpiry),
+		HttpOnly: true,
+		Secure:   h.config.Cookie.Secure,
+		SameSite: sameSiteModes[h.config.Cookie.SameSite],
+	}
+
+	http.SetCookie(c.Writer, refreshCookie)
+}
+
 func (h *AuthHandler) clearAuthCookies(c *gin.Context) {
+	h.clearAccessCookies(c)
+	c.SetCookie(refreshTokenCookie, "", -1, refreshTokenCookiePath, h.config.Cookie.Domain, h.config.Cookie.Secure, true)
+}
+
+// clearAccessCookies removes the access and CSRF tokens but keeps the refresh
+// token, so the client can get back to its own session by refreshing.
+func (h *AuthHandler) clearAccessCookies(c *gin.Context) {
 	c.SetCookie("access_token", "", -1, "/", h.config.Cookie.Domain, h.config.Cookie.Secure, h.config.Cookie.HTTPOnly)
 	c.SetCookie("csrf_token", "", -1, "/", h.config.Cookie.Domain, h.config.Cookie.Secure, false)
 }
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type CommentHandler struct {
+	commentService service.CommentService
+}
+
+func NewCommentHandler(commentService service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+func (h *CommentHandler) List(c *gin.Context) {
+	var params dto.CommentQueryParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	comments, replies, meta, err := h.commentService.List(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), params)
+	if err != nil {
+		respondCommentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("comments retrieved successfully", dto.ToCommentListResponse(comments, replies, meta)))
+}
+
+func (h *CommentHandler) Create(c *gin.Context) {
+	var req dto.CreateCommentRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	comment, err := h.commentService.Create(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), req)
+	if err != nil {
+		respondCommentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("comment created successfully", dto.ToCommentResponse(comment)))
+}
+
+func (h *CommentHandler) Update(c *gin.Context) {
+	var req dto.UpdateCommentRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	comment, err := h.commentService.Update(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), c.Param("comment_id"), req)
+	if err != nil {
+		respondCommentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("comment updated successfully", dto.ToCommentResponse(comment)))
+}
+
+func (h *CommentHandler) Delete(c *gin.Context) {
+	if err := h.commentService.Delete(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), c.Param("comment_id")); err != nil {
+		respondCommentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("comment deleted successfully", nil))
+}
+
+func respondCommentError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "task not found", "comment not found":
+		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+	case "cannot modify another user's comment":
+		c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+	case "invalid user ID", "invalid task ID", "invalid comment ID", "comment body is required", "cannot reply to a reply":
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+	}
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type EmailVerificationHandler struct {
+	emailVerificationService service.EmailVerificationService
+}
+
+func NewEmailVerificationHandler(emailVerificationService service.EmailVerificationService) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		emailVerificationService: emailVerificationService,
+	}
+}
+
+func (h *EmailVerificationHandler) Verify(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, err := h.emailVerificationService.Verify(c.Request.Context(), req.Token)
+	if err != nil {
+		switch err.Error() {
+		case "invalid or expired verification token":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("email verified successfully", dto.ToUserResponse(user)))
+}
+
+func (h *EmailVerificationHandler) Resend(c *gin.Context) {
+	var req dto.ResendVerificationRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	if err := h.emailVerificationService.Resend(c.Request.Context(), req.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse("failed to send verification email"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("if the email belongs to an unverified account, a verification link has been sent", nil))
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type ImpersonationHandler struct {
+	impersonationService service.ImpersonationService
+	authHandler          *AuthHandler
+	config               *config.Config
+}
+
+func NewImpersonationHandler(impersonationService service.ImpersonationService, authHandler *AuthHandler, config *config.Config) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		impersonationService: impersonationService,
+		authHandler:          authHandler,
+		config:               config,
+	}
+}
+
+// Start hands out an access token for the requested user. In cookie mode it
+// replaces the admin's access cookie while the refresh cookie is kept, so
+// refreshing returns to the admin's own session.
+func (h *ImpersonationHandler) Start(c *gin.Context) {
+	var req dto.StartImpersonationRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, tokens, err := h.impersonationService.Start(c.Request.Context(), middleware.GetClaims(c), req.UserID, req.Reason)
+	if err != nil {
+		switch err.Error() {
+		case "user not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "invalid user ID", "cannot impersonate yourself":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		case "cannot impersonate an administrator", "already impersonating a user":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	response := dto.ImpersonationResponse{
+		User:      dto.ToUserResponse(user),
+		ExpiresIn: int(h.config.Impersonation.Expiry.Seconds()),
+	}
+
+	if h.config.Server.AuthCookie {
+		h.authHandler.setAuthCookies(c, tokens.AccessToken, tokens.CSRFToken)
+	} else {
+		response.AccessToken = tokens.AccessToken
+		response.CSRFToken = tokens.CSRFToken
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("impersonation started", response))
+}
+
+func (h *ImpersonationHandler) Stop(c *gin.Context) {
+	if err := h.impersonationService.Stop(c.Request.Context(), middleware.GetClaims(c)); err != nil {
+		switch err.Error() {
+		case "not impersonating a user":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	if h.config.Server.AuthCookie {
+		h.authHandler.clearAccessCookies(c)
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("impersonation stopped", nil))
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type LabelHandler struct {
+	labelService service.LabelService
+}
+
+func NewLabelHandler(labelService service.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: labelService,
+	}
+}
+
+func (h *LabelHandler) Create(c *gin.Context) {
+	var req dto.CreateLabelRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	label, err := h.labelService.Create(c.Request.Context(), middleware.GetUserID(c), req)
+	if err != nil {
+		respondLabelError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("label created successfully", dto.ToLabelResponse(label)))
+}
+
+func (h *LabelHandler) List(c *gin.Context) {
+	labels, err := h.labelService.List(c.Request.Context())
+	if err != nil {
+		respondLabelError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("labels retrieved successfully", dto.ToLabelListResponse(labels)))
+}
+
+func (h *LabelHandler) Update(c *gin.Context) {
+	var req dto.UpdateLabelRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	label, err := h.labelService.Update(c.Request.Context(), middleware.GetUserID(c), c.Param("id"), req)
+	if err != nil {
+		respondLabelError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("label updated successfully", dto.ToLabelResponse(label)))
+}
+
+func (h *LabelHandler) Delete(c *gin.Context) {
+	if err := h.labelService.Delete(c.Request.Context(), middleware.GetUserID(c), c.Param("id")); err != nil {
+		respondLabelError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("label deleted successfully", nil))
+}
+
+func respondLabelError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "label not found":
+		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+	case "label already exists":
+		c.JSON(http.StatusConflict, dto.ErrorResponse(err.Error()))
+	case "invalid user ID", "invalid label ID", "label name is required":
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+	}
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type MFAHandler struct {
+	mfaService service.MFAService
+}
+
+func NewMFAHandler(mfaService service.MFAService) *MFAHandler {
+	return &MFAHandler{
+		mfaService: mfaService,
+	}
+}
+
+func (h *MFAHandler) Enroll(c *gin.Context) {
+	secret, uri, err := h.mfaService.Enroll(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("mfa enrollment started", dto.MFAEnrollResponse{
+		Secret:     secret,
+		OTPAuthURI: uri,
+	}))
+}
+
+func (h *MFAHandler) Confirm(c *gin.Context) {
+	var req dto.MFAConfirmRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	recoveryCodes, err := h.mfaService.Confirm(c.Request.Context(), middleware.GetUserID(c), req.Code)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("mfa enabled successfully", dto.MFAConfirmResponse{
+		RecoveryCodes: recoveryCodes,
+	}))
+}
+
+func (h *MFAHandler) Disable(c *gin.Context) {
+	var req dto.MFADisableRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	if err := h.mfaService.Disable(c.Request.Context(), middleware.GetUserID(c), req.Password, req.Code); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("mfa disabled successfully", nil))
+}
+
+func (h *MFAHandler) Reset(c *gin.Context) {
+	if err := h.mfaService.Reset(c.Request.Context(), c.Param("id")); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("mfa reset successfully", nil))
+}
+
+func (h *MFAHandler) handleError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "user not found":
+		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+	case "mfa is already enabled", "mfa is not enabled", "mfa enrollment has not been started":
+		c.JSON(http.StatusConflict, dto.ErrorResponse(err.Error()))
+	case "invalid mfa code", "invalid user ID":
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+	case "password is incorrect":
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+)
+
+const (
+	oidcFlowCookie     = "oidc_flow"
+	oidcFlowCookiePath = "/api/v1/auth/oidc"
+)
+
+type OIDCHandler struct {
+	oidcService service.OIDCService
+	authHandler *AuthHandler
+	config      *config.Config
+}
+
+func NewOIDCHandler(oidcService service.OIDCService, authHandler *AuthHandler, config *config.Config) *OIDCHandler {
+	return &OIDCHandler{
+		oidcService: oidcService,
+		authHandler: authHandler,
+		config:      config,
+	}
+}
+
+func (h *OIDCHandler) Login(c *gin.Context) {
+	authURL, flowToken, err := h.oidcService.BeginLogin(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		switch err.Error() {
+		case "unknown identity provider":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "identity provider is unavailable":
+			c.JSON(http.StatusBadGateway, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	h.setFlowCookie(c, flowToken, time.Now().Add(h.config.OIDC.FlowExpiry))
+	c.Redirect(http.StatusFound, authURL)
+}
+
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	flowToken, _ := c.Cookie(oidcFlowCookie)
+
+	// the flow token is single use either way
+	h.setFlowCookie(c, "", time.Unix(0, 0))
+
+	if c.Query("error") != "" {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse("identity provider login failed"))
+		return
+	}
+
+	user, tokens, err := h.oidcService.CompleteLogin(c.Request.Context(), c.Param("provider"), c.Query("code"), c.Query("state"), flowToken, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		var mfaRequired *service.MFARequiredError
+		if errors.As(err, &mfaRequired) {
+			c.JSON(http.StatusOK, dto.SuccessResponse(err.Error(), dto.MFAChallengeResponse{
+				MFARequired: true,
+				MFAToken:    mfaRequired.Token,
+				ExpiresIn:   int(mfaRequired.ExpiresIn.Seconds()),
+			}))
+			return
+		}
+		switch err.Error() {
+		case "unknown identity provider":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "invalid or expired login state":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		case "identity provider login failed":
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+		case "email address is not verified by the identity provider", "no account exists for this email":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("login successful", h.authHandler.buildAuthResponse(c, user, tokens)))
+}
+
+// setFlowCookie stores the flow token for the provider's redirect back. The
+// redirect is a cross-site navigation, so the cookie has to be SameSite=Lax
+// regardless of the configured mode.
+func (h *OIDCHandler) setFlowCookie(c *gin.Context, flowToken string, expires time.Time) {
+	flowCookie := &http.Cookie{
+		Name:     oidcFlowCookie,
+		Value:    flowToken,
+		Path:     oidcFlowCookiePath,
+		Domain:   h.config.Cookie.Domain,
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   h.config.Cookie.Secure,
+		SameSite: http.SameSiteLaxMode,
+	}
+
+	http.SetCookie(c.Writer, flowCookie)
+}
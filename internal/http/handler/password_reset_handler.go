@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type PasswordResetHandler struct {
+	passwordResetService service.PasswordResetService
+}
+
+func NewPasswordResetHandler(passwordResetService service.PasswordResetService) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		passwordResetService: passwordResetService,
+	}
+}
+
+func (h *PasswordResetHandler) Forgot(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	if err := h.passwordResetService.RequestReset(c.Request.Context(), req.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse("failed to process password reset request"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("if the email is registered, a password reset link has been sent", nil))
+}
+
+func (h *PasswordResetHandler) Reset(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	if err := h.passwordResetService.Reset(c.Request.Context(), req.Token, req.Password); err != nil {
+		switch err.Error() {
+		case "invalid or expired reset token":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		case "password does not meet the policy requirements":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("password has been reset successfully", nil))
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type PersonalAccessTokenHandler struct {
+	personalAccessTokenService service.PersonalAccessTokenService
+}
+
+func NewPersonalAccessTokenHandler(personalAccessTokenService service.PersonalAccessTokenService) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		personalAccessTokenService: personalAccessTokenService,
+	}
+}
+
+func (h *PersonalAccessTokenHandler) Create(c *gin.Context) {
+	var req dto.CreatePersonalAccessTokenRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	token, rawToken, err := h.personalAccessTokenService.Create(c.Request.Context(), middleware.GetUserID(c), req.Name, req.Scopes, req.ExpiresInDays)
+	if err != nil {
+		switch {
+		case strings.HasPrefix(err.Error(), "scope not permitted"):
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		case strings.HasPrefix(err.Error(), "invalid scope"), err.Error() == "expiry exceeds the maximum allowed lifetime":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("personal access token created successfully", dto.CreatedPersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: dto.ToPersonalAccessTokenResponse(token),
+		Token:                       rawToken,
+	}))
+}
+
+func (h *PersonalAccessTokenHandler) List(c *gin.Context) {
+	tokens, err := h.personalAccessTokenService.List(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("personal access tokens retrieved successfully", dto.ToPersonalAccessTokenListResponse(tokens)))
+}
+
+func (h *PersonalAccessTokenHandler) Revoke(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.personalAccessTokenService.Revoke(c.Request.Context(), middleware.GetUserID(c), id); err != nil {
+		switch err.Error() {
+		case "personal access token not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "invalid personal access token ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("personal access token revoked successfully", nil))
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type ProjectHandler struct {
+	projectService service.ProjectService
+}
+
+func NewProjectHandler(projectService service.ProjectService) *ProjectHandler {
+	return &ProjectHandler{
+		projectService: projectService,
+	}
+}
+
+func (h *ProjectHandler) Create(c *gin.Context) {
+	var req dto.CreateProjectRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.Create(c.Request.Context(), userID, req)
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("project created successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func (h *ProjectHandler) List(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	projects, err := h.projectService.List(c.Request.Context(), userID)
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("projects retrieved successfully", dto.ToProjectListResponse(projects, userID)))
+}
+
+func (h *ProjectHandler) GetByID(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.GetByID(c.Request.Context(), userID, c.Param("id"))
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("project retrieved successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func (h *ProjectHandler) Update(c *gin.Context) {
+	var req dto.UpdateProjectRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.Update(c.Request.Context(), userID, c.Param("id"), req)
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("project updated successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func (h *ProjectHandler) Delete(c *gin.Context) {
+	if err := h.projectService.Delete(c.Request.Context(), middleware.GetUserID(c), c.Param("id")); err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("project deleted successfully", nil))
+}
+
+func (h *ProjectHandler) AddMember(c *gin.Context) {
+	var req dto.AddProjectMemberRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.AddMember(c.Request.Context(), userID, c.Param("id"), req)
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SuccessResponse("member added successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func (h *ProjectHandler) UpdateMember(c *gin.Context) {
+	var req dto.UpdateProjectMemberRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.UpdateMember(c.Request.Context(), userID, c.Param("id"), c.Param("user_id"), req.Role)
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("member updated successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func (h *ProjectHandler) RemoveMember(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	project, err := h.projectService.RemoveMember(c.Request.Context(), userID, c.Param("id"), c.Param("user_id"))
+	if err != nil {
+		respondProjectError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("member removed successfully", dto.ToProjectResponse(project, userID)))
+}
+
+func respondProjectError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "project not found", "member not found", "user not found":
+		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+	case "insufficient project permissions":
+		c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+	case "user is already a member of the project", "project must keep at least one owner":
+		c.JSON(http.StatusConflict, dto.ErrorResponse(err.Error()))
+	case "invalid user ID", "invalid project ID", "invalid member ID", "invalid project role":
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+	}
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+)
+
+type SessionHandler struct {
+	sessionService service.SessionService
+}
+
+func NewSessionHandler(sessionService service.SessionService) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+func (h *SessionHandler) List(c *gin.Context) {
+	sessions, err := h.sessionService.List(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("sessions retrieved successfully", dto.ToSessionListResponse(sessions, middleware.GetClaims(c).SessionID)))
+}
+
+func (h *SessionHandler) Revoke(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.sessionService.Revoke(c.Request.Context(), middleware.GetUserID(c), id); err != nil {
+		switch err.Error() {
+		case "session not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "invalid session ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("session revoked successfully", nil))
+}
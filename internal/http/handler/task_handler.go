@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
 	"github.com/grachmannico95/mileapp-test-be/internal/service"
 	"github.com/grachmannico95/mileapp-test-be/internal/util"
 )
@@ -30,9 +31,16 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		return
 	}
 
-	task, err := h.taskService.Create(c.Request.Context(), req)
+	task, err := h.taskService.Create(c.Request.Context(), middleware.GetUserID(c), req)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		switch err.Error() {
+		case "project not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "insufficient project permissions":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		}
 		return
 	}
 
@@ -43,7 +51,7 @@ func (h *TaskHandler) Create(c *gin.Context) {
 func (h *TaskHandler) GetByID(c *gin.Context) {
 	id := c.Param("id")
 
-	task, err := h.taskService.GetByID(c.Request.Context(), id)
+	task, err := h.taskService.GetByID(c.Request.Context(), middleware.GetUserID(c), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
 		return
@@ -61,9 +69,34 @@ func (h *TaskHandler) List(c *gin.Context) {
 		return
 	}
 
-	tasks, meta, err := h.taskService.List(c.Request.Context(), params)
+	h.list(c, params)
+}
+
+// ListByProject lists the tasks of the project in the path, with the same
+// filters as List.
+func (h *TaskHandler) ListByProject(c *gin.Context) {
+	var params dto.TaskQueryParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	params.ProjectID = c.Param("id")
+	h.list(c, params)
+}
+
+func (h *TaskHandler) list(c *gin.Context, params dto.TaskQueryParams) {
+	tasks, meta, err := h.taskService.List(c.Request.Context(), middleware.GetUserID(c), params)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		switch err.Error() {
+		case "project not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "invalid project ID", "invalid assignee ID", "assignee and assigned_to_me cannot be combined":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
 		return
 	}
 
@@ -81,13 +114,16 @@ func (h *TaskHandler) Update(c *gin.Context) {
 		return
 	}
 
-	task, err := h.taskService.Update(c.Request.Context(), id, req)
+	task, err := h.taskService.Update(c.Request.Context(), middleware.GetUserID(c), id, req)
 	if err != nil {
-		if err.Error() == "task not found" {
+		switch err.Error() {
+		case "task not found":
 			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
-			return
+		case "insufficient project permissions", "only the task creator can change this task":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
 		}
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
 		return
 	}
 
@@ -98,13 +134,16 @@ func (h *TaskHandler) Update(c *gin.Context) {
 func (h *TaskHandler) Delete(c *gin.Context) {
 	id := c.Param("id")
 
-	err := h.taskService.Delete(c.Request.Context(), id)
+	err := h.taskService.Delete(c.Request.Context(), middleware.GetUserID(c), id)
 	if err != nil {
-		if err.Error() == "task not found" {
+		switch err.Error() {
+		case "task not found":
 			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
-			return
+		case "insufficient project permissions", "only the task creator can change this task":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
 		}
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
 		return
 	}
 
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+type UserHandler struct {
+	userService service.UserService
+}
+
+func NewUserHandler(userService service.UserService) *UserHandler {
+	return &UserHandler{
+		userService: userService,
+	}
+}
+
+func (h *UserHandler) GetMe(c *gin.Context) {
+	user, err := h.userService.GetProfile(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		if err.Error() == "user not found" {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("profile retrieved successfully", dto.ToUserResponse(user)))
+}
+
+func (h *UserHandler) UpdateMe(c *gin.Context) {
+	var req dto.UpdateProfileRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, err := h.userService.UpdateProfile(c.Request.Context(), middleware.GetUserID(c), req)
+	if err != nil {
+		if err.Error() == "user not found" {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("profile updated successfully", dto.ToUserResponse(user)))
+}
+
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	err := h.userService.ChangePassword(c.Request.Context(), middleware.GetUserID(c), req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		switch err.Error() {
+		case "current password is incorrect":
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+		case "new password must be different from the current password":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		case "password does not meet the policy requirements":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		case "user not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("password changed successfully, please log in again", nil))
+}
+
+func (h *UserHandler) List(c *gin.Context) {
+	var params dto.UserQueryParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	users, meta, err := h.userService.List(c.Request.Context(), params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		return
+	}
+
+	response := dto.ToUserListResponse(users, meta)
+	c.JSON(http.StatusOK, dto.SuccessResponse("users retrieved successfully", response))
+}
+
+func (h *UserHandler) UpdateRole(c *gin.Context) {
+	id := c.Param("id")
+
+	var req dto.UpdateUserRoleRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse("validation failed", util.ParseValidationError(err)...))
+		return
+	}
+
+	user, err := h.userService.UpdateRole(c.Request.Context(), middleware.GetUserID(c), id, req.Role)
+	if err != nil {
+		switch err.Error() {
+		case "user not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse(err.Error()))
+		case "cannot change your own role":
+			c.JSON(http.StatusForbidden, dto.ErrorResponse(err.Error()))
+		case "invalid role", "invalid user ID":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse(err.Error()))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse("user role updated successfully", dto.ToUserResponse(user)))
+}
@@ -1,17 +1,46 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/grachmannico95/mileapp-test-be/internal/config"
 	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
 	"github.com/grachmannico95/mileapp-test-be/internal/util"
 )
 
-func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
+const (
+	ClaimsContextKey     = "claims"
+	AuthMethodContextKey = "auth_method"
+
+	AuthMethodJWT                 = "jwt"
+	AuthMethodPersonalAccessToken = "personal_access_token"
+)
+
+func AuthMiddleware(cfg *config.Config, tokenService service.TokenService, personalAccessTokenService service.PersonalAccessTokenService, sessionService service.SessionService, auditService service.AuditService) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// personal access tokens are accepted from the header in every mode
+		if rawToken := bearerToken(c); strings.HasPrefix(rawToken, service.PersonalAccessTokenPrefix) {
+			claims, err := personalAccessTokenService.Authenticate(c.Request.Context(), rawToken, c.ClientIP())
+			if err != nil {
+				if errors.Is(err, service.ErrInvalidPersonalAccessToken) {
+					c.JSON(http.StatusUnauthorized, dto.ErrorResponse(err.Error()))
+				} else {
+					c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+				}
+				c.Abort()
+				return
+			}
+
+			c.Set(ClaimsContextKey, claims)
+			c.Set(AuthMethodContextKey, AuthMethodPersonalAccessToken)
+			c.Next()
+			return
+		}
+
 		var tokenString string
 
 		if cfg.Server.AuthCookie {
@@ -23,13 +52,7 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 				return
 			}
 		} else {
-			authHeader := c.GetHeader("Authorization")
-			if authHeader != "" {
-				parts := strings.SplitN(authHeader, " ", 2)
-				if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
-					tokenString = parts[1]
-				}
-			}
+			tokenString = bearerToken(c)
 
 			if tokenString == "" {
 				c.JSON(http.StatusUnauthorized, dto.ErrorResponse("authentication required"))
@@ -38,13 +61,99 @@ func AuthMiddleware(cfg *config.Config) gin.HandlerFunc {
 			}
 		}
 
-		_, err := util.ValidateJWT(tokenString, cfg.JWT.Secret)
+		claims, err := util.ValidateJWT(tokenString, &cfg.JWT)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, dto.ErrorResponse("invalid or expired token"))
 			c.Abort()
 			return
 		}
 
+		revoked, err := tokenService.IsRevoked(c.Request.Context(), claims)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse(err.Error()))
+			c.Abort()
+			return
+		}
+
+		if revoked {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse("token has been revoked"))
+			c.Abort()
+			return
+		}
+
+		// last-seen tracking is best effort and must not fail the request
+		_ = sessionService.Touch(c.Request.Context(), claims, c.ClientIP())
+
+		c.Set(ClaimsContextKey, claims)
+		c.Set(AuthMethodContextKey, AuthMethodJWT)
+
+		if claims.IsImpersonation() {
+			serveImpersonated(c, claims, auditService)
+			return
+		}
+
 		c.Next()
 	}
 }
+
+// DenyPersonalAccessTokens rejects requests authenticated with a personal
+// access token, for endpoints that must only be reachable from a real login
+// session such as managing the tokens themselves.
+func DenyPersonalAccessTokens() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if IsPersonalAccessToken(c) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse("personal access tokens are not allowed for this endpoint"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func bearerToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" {
+		return ""
+	}
+
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// IsPersonalAccessToken reports whether the request was authenticated with a
+// personal access token rather than a login session.
+func IsPersonalAccessToken(c *gin.Context) bool {
+	return c.GetString(AuthMethodContextKey) == AuthMethodPersonalAccessToken
+}
+
+// GetClaims returns the JWT claims stored by AuthMiddleware, or nil when the
+// request was not authenticated.
+func GetClaims(c *gin.Context) *util.JWTClaims {
+	value, exists := c.Get(ClaimsContextKey)
+	if !exists {
+		return nil
+	}
+
+	claims, ok := value.(*util.JWTClaims)
+	if !ok {
+		return nil
+	}
+
+	return claims
+}
+
+// GetUserID returns the authenticated user's ID, or an empty string when the
+// request was not authenticated.
+func GetUserID(c *gin.Context) string {
+	claims := GetClaims(c)
+	if claims == nil {
+		return ""
+	}
+
+	return claims.UserID
+}
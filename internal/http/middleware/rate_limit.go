@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/pkg/ratelimit"
+)
+
+// RateLimitKeyFunc derives the identity a request is counted against.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+func RateLimitByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// RateLimitByUser counts authenticated requests per user and falls back to the
+// client IP when no user is attached to the request.
+func RateLimitByUser(c *gin.Context) string {
+	if userID := GetUserID(c); userID != "" {
+		return "user:" + userID
+	}
+	return RateLimitByIP(c)
+}
+
+// RateLimitMiddleware limits requests within scope to limit per window and
+// reports the quota through the RateLimit-* headers. A nil store or a
+// non-positive limit disables the check.
+func RateLimitMiddleware(store ratelimit.Store, scope string, limit int, window time.Duration, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if store == nil || limit <= 0 {
+			c.Next()
+			return
+		}
+
+		result, err := store.Allow(c.Request.Context(), scope+":"+keyFunc(c), limit, window)
+		if err != nil {
+			// fail open, an unavailable store must not take the API down
+			log.Printf("rate limit check failed: %v", err)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			c.JSON(http.StatusTooManyRequests, dto.ErrorResponse("too many requests"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+// RequirePermission only lets the request through when the authenticated
+// user's role grants permission and, for personal access tokens, the token
+// was given the matching scope. It must run after AuthMiddleware.
+func RequirePermission(permission model.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims := GetClaims(c)
+		if claims == nil {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse("authentication required"))
+			c.Abort()
+			return
+		}
+
+		role := model.Role(claims.Role)
+		if role == "" {
+			role = model.RoleMember
+		}
+
+		if !role.HasPermission(permission) || !hasScope(claims.Scopes, permission) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse("insufficient permissions"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// hasScope treats nil scopes as unrestricted; only personal access tokens
+// carry scopes.
+func hasScope(scopes []string, permission model.Permission) bool {
+	if scopes == nil {
+		return true
+	}
+
+	for _, scope := range scopes {
+		if model.Permission(scope) == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+)
+
+// RequestMetaMiddleware makes the client IP and user agent available to
+// services through the request context.
+func RequestMetaMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := util.WithRequestMeta(c.Request.Context(), util.RequestMeta{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		})
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -6,19 +6,48 @@ import (
 	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/router/routes"
+	"github.com/grachmannico95/mileapp-test-be/internal/service"
+	"github.com/grachmannico95/mileapp-test-be/pkg/ratelimit"
 )
 
-func NewRouter(cfg *config.Config, authHandler *handler.AuthHandler, taskHandler *handler.TaskHandler) *gin.Engine {
+func NewRouter(cfg *config.Config, tokenService service.TokenService, personalAccessTokenService service.PersonalAccessTokenService, sessionService service.SessionService, auditService service.AuditService, rateLimitStore ratelimit.Store, authHandler *handler.AuthHandler, taskHandler *handler.TaskHandler, userHandler *handler.UserHandler, passwordResetHandler *handler.PasswordResetHandler, emailVerificationHandler *handler.EmailVerificationHandler, mfaHandler *handler.MFAHandler, personalAccessTokenHandler *handler.PersonalAccessTokenHandler, oidcHandler *handler.OIDCHandler, sessionHandler *handler.SessionHandler, auditHandler *handler.AuditHandler, impersonationHandler *handler.ImpersonationHandler, projectHandler *handler.ProjectHandler, labelHandler *handler.LabelHandler, commentHandler *handler.CommentHandler, attachmentHandler *handler.AttachmentHandler) *gin.Engine {
 	router := gin.Default()
+	// entries were validated with the config, so this cannot fail
+	_ = router.SetTrustedProxies(cfg.Server.TrustedProxies)
 	router.Use(middleware.CORSMiddleware(cfg))
 	router.Use(middleware.SecurityHeadersMiddleware())
+	router.Use(middleware.RequestMetaMiddleware())
 
 	routes.RegisterHealthRoutes(router)
+	routes.RegisterWellKnownRoutes(router, cfg.JWT.Keys())
+
+	mw := routes.Middlewares{
+		Auth:            middleware.AuthMiddleware(cfg, tokenService, personalAccessTokenService, sessionService, auditService),
+		CSRF:            middleware.CSRFMiddleware(cfg),
+		AuthRateLimit:   middleware.RateLimitMiddleware(rateLimitStore, "auth", cfg.RateLimit.LoginRequests, cfg.RateLimit.LoginWindow, middleware.RateLimitByIP),
+		UserRateLimit:   middleware.RateLimitMiddleware(rateLimitStore, "user", cfg.RateLimit.UserRequests, cfg.RateLimit.UserWindow, middleware.RateLimitByUser),
+		SessionOnly:     middleware.DenyPersonalAccessTokens(),
+		NoImpersonation: middleware.DenyImpersonation(),
+	}
 
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.RateLimitMiddleware(rateLimitStore, "api", cfg.RateLimit.Requests, cfg.RateLimit.Window, middleware.RateLimitByIP))
 	{
-		routes.RegisterAuthRoutes(v1, cfg, authHandler)
-		routes.RegisterTaskRoutes(v1, cfg, authHandler, taskHandler)
+		routes.RegisterAuthRoutes(v1, mw, authHandler)
+		routes.RegisterPasswordResetRoutes(v1, mw, passwordResetHandler)
+		routes.RegisterOIDCRoutes(v1, mw, oidcHandler)
+		routes.RegisterEmailVerificationRoutes(v1, mw, emailVerificationHandler)
+		routes.RegisterTaskRoutes(v1, mw, taskHandler)
+		routes.RegisterProjectRoutes(v1, mw, projectHandler)
+		routes.RegisterLabelRoutes(v1, mw, labelHandler)
+		routes.RegisterCommentRoutes(v1, mw, commentHandler)
+		routes.RegisterAttachmentRoutes(v1, mw, attachmentHandler)
+		routes.RegisterUserRoutes(v1, mw, userHandler)
+		routes.RegisterMFARoutes(v1, mw, mfaHandler)
+		routes.RegisterPersonalAccessTokenRoutes(v1, mw, personalAccessTokenHandler)
+		routes.RegisterSessionRoutes(v1, mw, sessionHandler)
+		routes.RegisterAuditRoutes(v1, mw, auditHandler)
+		routes.RegisterImpersonationRoutes(v1, mw, impersonationHandler)
 	}
 
 	return router
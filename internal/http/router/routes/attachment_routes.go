@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterAttachmentRoutes(v1 *gin.RouterGroup, mw Middlewares, attachmentHandler *handler.AttachmentHandler) {
+	attachments := v1.Group("/tasks/:id/attachments")
+	attachments.Use(mw.Auth)
+	attachments.Use(mw.UserRateLimit)
+	attachments.Use(mw.CSRF)
+	{
+		attachments.POST("", middleware.RequirePermission(model.PermissionTasksWrite), attachmentHandler.Upload)
+		attachments.GET("/:attachment_id", middleware.RequirePermission(model.PermissionTasksRead), attachmentHandler.Download)
+		attachments.DELETE("/:attachment_id", middleware.RequirePermission(model.PermissionTasksWrite), attachmentHandler.Delete)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterAuditRoutes(v1 *gin.RouterGroup, mw Middlewares, auditHandler *handler.AuditHandler) {
+	audit := v1.Group("/audit")
+	audit.Use(mw.Auth)
+	audit.Use(mw.UserRateLimit)
+	audit.Use(mw.CSRF)
+	audit.Use(middleware.RequirePermission(model.PermissionAuditRead))
+	{
+		audit.GET("", auditHandler.List)
+	}
+}
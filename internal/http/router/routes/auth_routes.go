@@ -2,12 +2,26 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/grachmannico95/mileapp-test-be/internal/config"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
 )
 
-func RegisterAuthRoutes(v1 *gin.RouterGroup, cfg *config.Config, authHandler *handler.AuthHandler) {
-	v1.POST("/login", authHandler.Login)
-	v1.POST("/logout", authHandler.Logout, middleware.AuthMiddleware(cfg), middleware.CSRFMiddleware(cfg))
+func RegisterAuthRoutes(v1 *gin.RouterGroup, mw Middlewares, authHandler *handler.AuthHandler) {
+	v1.POST("/login", mw.AuthRateLimit, authHandler.Login)
+	v1.POST("/login/mfa", mw.AuthRateLimit, authHandler.LoginMFA)
+	v1.POST("/register", mw.AuthRateLimit, authHandler.Register)
+	v1.POST("/token/refresh", mw.AuthRateLimit, authHandler.Refresh)
+	v1.POST("/logout", mw.Auth, mw.CSRF, mw.SessionOnly, mw.NoImpersonation, authHandler.Logout)
+	v1.GET("/csrf", mw.Auth, mw.SessionOnly, authHandler.CSRFToken)
+	v1.POST("/logout/all", mw.Auth, mw.CSRF, mw.SessionOnly, mw.NoImpersonation, authHandler.LogoutAll)
+
+	invites := v1.Group("/invites")
+	invites.Use(mw.Auth)
+	invites.Use(mw.UserRateLimit)
+	invites.Use(mw.CSRF)
+	invites.Use(mw.NoImpersonation)
+	{
+		invites.POST("", middleware.RequirePermission(model.PermissionInvitesWrite), authHandler.CreateInvite)
+	}
 }
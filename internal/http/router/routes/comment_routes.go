@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterCommentRoutes(v1 *gin.RouterGroup, mw Middlewares, commentHandler *handler.CommentHandler) {
+	comments := v1.Group("/tasks/:id/comments")
+	comments.Use(mw.Auth)
+	comments.Use(mw.UserRateLimit)
+	comments.Use(mw.CSRF)
+	{
+		comments.GET("", middleware.RequirePermission(model.PermissionTasksRead), commentHandler.List)
+		comments.POST("", middleware.RequirePermission(model.PermissionTasksWrite), commentHandler.Create)
+		comments.PATCH("/:comment_id", middleware.RequirePermission(model.PermissionTasksWrite), commentHandler.Update)
+		comments.DELETE("/:comment_id", middleware.RequirePermission(model.PermissionTasksWrite), commentHandler.Delete)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+)
+
+func RegisterEmailVerificationRoutes(v1 *gin.RouterGroup, mw Middlewares, emailVerificationHandler *handler.EmailVerificationHandler) {
+	email := v1.Group("/auth/email")
+	email.Use(mw.AuthRateLimit)
+	{
+		email.POST("/verify", emailVerificationHandler.Verify)
+		email.POST("/resend", emailVerificationHandler.Resend)
+	}
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterImpersonationRoutes(v1 *gin.RouterGroup, mw Middlewares, impersonationHandler *handler.ImpersonationHandler) {
+	impersonation := v1.Group("/impersonation")
+	impersonation.Use(mw.Auth)
+	impersonation.Use(mw.UserRateLimit)
+	impersonation.Use(mw.CSRF)
+	impersonation.Use(mw.SessionOnly)
+	{
+		impersonation.POST("", mw.NoImpersonation, middleware.RequirePermission(model.PermissionImpersonate), impersonationHandler.Start)
+		impersonation.DELETE("", impersonationHandler.Stop)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterLabelRoutes(v1 *gin.RouterGroup, mw Middlewares, labelHandler *handler.LabelHandler) {
+	labels := v1.Group("/labels")
+	labels.Use(mw.Auth)
+	labels.Use(mw.UserRateLimit)
+	labels.Use(mw.CSRF)
+	{
+		labels.GET("", middleware.RequirePermission(model.PermissionLabelsRead), labelHandler.List)
+		labels.POST("", middleware.RequirePermission(model.PermissionLabelsWrite), labelHandler.Create)
+		labels.PATCH("/:id", middleware.RequirePermission(model.PermissionLabelsWrite), labelHandler.Update)
+		labels.DELETE("/:id", middleware.RequirePermission(model.PermissionLabelsWrite), labelHandler.Delete)
+	}
+}
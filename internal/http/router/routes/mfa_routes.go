@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterMFARoutes(v1 *gin.RouterGroup, mw Middlewares, mfaHandler *handler.MFAHandler) {
+	mfa := v1.Group("/me/mfa")
+	mfa.Use(mw.Auth)
+	mfa.Use(mw.UserRateLimit)
+	mfa.Use(mw.CSRF)
+	mfa.Use(mw.SessionOnly)
+	mfa.Use(mw.NoImpersonation)
+	{
+		mfa.POST("/enroll", mfaHandler.Enroll)
+		mfa.POST("/confirm", mfaHandler.Confirm)
+		mfa.POST("/disable", mfaHandler.Disable)
+	}
+
+	admin := v1.Group("/admin")
+	admin.Use(mw.Auth)
+	admin.Use(mw.UserRateLimit)
+	admin.Use(mw.CSRF)
+	admin.Use(middleware.RequirePermission(model.PermissionUsersManage))
+	{
+		admin.DELETE("/users/:id/mfa", mfaHandler.Reset)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// Middlewares bundles the shared middleware handlers that route groups are built from.
+type Middlewares struct {
+	Auth            gin.HandlerFunc
+	CSRF            gin.HandlerFunc
+	AuthRateLimit   gin.HandlerFunc
+	UserRateLimit   gin.HandlerFunc
+	SessionOnly     gin.HandlerFunc
+	NoImpersonation gin.HandlerFunc
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+)
+
+func RegisterOIDCRoutes(v1 *gin.RouterGroup, mw Middlewares, oidcHandler *handler.OIDCHandler) {
+	oidc := v1.Group("/auth/oidc")
+	oidc.Use(mw.AuthRateLimit)
+	{
+		oidc.GET("/:provider/login", oidcHandler.Login)
+		oidc.GET("/:provider/callback", oidcHandler.Callback)
+	}
+}
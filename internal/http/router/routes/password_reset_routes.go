@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+)
+
+func RegisterPasswordResetRoutes(v1 *gin.RouterGroup, mw Middlewares, passwordResetHandler *handler.PasswordResetHandler) {
+	password := v1.Group("/auth/password")
+	password.Use(mw.AuthRateLimit)
+	{
+		password.POST("/forgot", passwordResetHandler.Forgot)
+		password.POST("/reset", passwordResetHandler.Reset)
+	}
+}
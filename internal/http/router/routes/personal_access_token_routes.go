@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+)
+
+func RegisterPersonalAccessTokenRoutes(v1 *gin.RouterGroup, mw Middlewares, personalAccessTokenHandler *handler.PersonalAccessTokenHandler) {
+	tokens := v1.Group("/me/tokens")
+	tokens.Use(mw.Auth)
+	tokens.Use(mw.UserRateLimit)
+	tokens.Use(mw.CSRF)
+	tokens.Use(mw.SessionOnly)
+	tokens.Use(mw.NoImpersonation)
+	{
+		tokens.POST("", personalAccessTokenHandler.Create)
+		tokens.GET("", personalAccessTokenHandler.List)
+		tokens.DELETE("/:id", personalAccessTokenHandler.Revoke)
+	}
+}
@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterProjectRoutes(v1 *gin.RouterGroup, mw Middlewares, projectHandler *handler.ProjectHandler) {
+	projects := v1.Group("/projects")
+	projects.Use(mw.Auth)
+	projects.Use(mw.UserRateLimit)
+	projects.Use(mw.CSRF)
+	{
+		projects.GET("", middleware.RequirePermission(model.PermissionProjectsRead), projectHandler.List)
+		projects.POST("", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.Create)
+		projects.GET("/:id", middleware.RequirePermission(model.PermissionProjectsRead), projectHandler.GetByID)
+		projects.PATCH("/:id", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.Update)
+		projects.DELETE("/:id", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.Delete)
+		projects.POST("/:id/members", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.AddMember)
+		projects.PATCH("/:id/members/:user_id", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.UpdateMember)
+		projects.DELETE("/:id/members/:user_id", middleware.RequirePermission(model.PermissionProjectsWrite), projectHandler.RemoveMember)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+)
+
+func RegisterSessionRoutes(v1 *gin.RouterGroup, mw Middlewares, sessionHandler *handler.SessionHandler) {
+	sessions := v1.Group("/sessions")
+	sessions.Use(mw.Auth)
+	sessions.Use(mw.UserRateLimit)
+	sessions.Use(mw.CSRF)
+	sessions.Use(mw.SessionOnly)
+	sessions.Use(mw.NoImpersonation)
+	{
+		sessions.GET("", sessionHandler.List)
+		sessions.DELETE("/:id", sessionHandler.Revoke)
+	}
+}
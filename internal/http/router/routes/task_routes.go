@@ -2,20 +2,22 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
-	"github.com/grachmannico95/mileapp-test-be/internal/config"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
 	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
 )
 
-func RegisterTaskRoutes(v1 *gin.RouterGroup, cfg *config.Config, authHandler *handler.AuthHandler, taskHandler *handler.TaskHandler) {
+func RegisterTaskRoutes(v1 *gin.RouterGroup, mw Middlewares, taskHandler *handler.TaskHandler) {
 	protected := v1.Group("/")
-	protected.Use(middleware.AuthMiddleware(cfg))
-	protected.Use(middleware.CSRFMiddleware(cfg))
+	protected.Use(mw.Auth)
+	protected.Use(mw.UserRateLimit)
+	protected.Use(mw.CSRF)
 	{
-		protected.GET("/tasks", taskHandler.List)
-		protected.GET("/tasks/:id", taskHandler.GetByID)
-		protected.POST("/tasks", taskHandler.Create)
-		protected.PUT("/tasks/:id", taskHandler.Update)
-		protected.DELETE("/tasks/:id", taskHandler.Delete)
+		protected.GET("/tasks", middleware.RequirePermission(model.PermissionTasksRead), taskHandler.List)
+		protected.GET("/tasks/:id", middleware.RequirePermission(model.PermissionTasksRead), taskHandler.GetByID)
+		protected.POST("/tasks", middleware.RequirePermission(model.PermissionTasksWrite), taskHandler.Create)
+		protected.PUT("/tasks/:id", middleware.RequirePermission(model.PermissionTasksWrite), taskHandler.Update)
+		protected.DELETE("/tasks/:id", middleware.RequirePermission(model.PermissionTasksWrite), taskHandler.Delete)
+		protected.GET("/projects/:id/tasks", middleware.RequirePermission(model.PermissionTasksRead), taskHandler.ListByProject)
 	}
 }
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/handler"
+	"github.com/grachmannico95/mileapp-test-be/internal/http/middleware"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+func RegisterUserRoutes(v1 *gin.RouterGroup, mw Middlewares, userHandler *handler.UserHandler) {
+	me := v1.Group("/me")
+	me.Use(mw.Auth)
+	me.Use(mw.UserRateLimit)
+	me.Use(mw.CSRF)
+	{
+		me.GET("", userHandler.GetMe)
+		me.PATCH("", mw.SessionOnly, userHandler.UpdateMe)
+		me.POST("/password", mw.SessionOnly, mw.NoImpersonation, userHandler.ChangePassword)
+	}
+
+	admin := v1.Group("/admin")
+	admin.Use(mw.Auth)
+	admin.Use(mw.UserRateLimit)
+	admin.Use(mw.CSRF)
+	admin.Use(middleware.RequirePermission(model.PermissionUsersManage))
+	{
+		admin.GET("/users", userHandler.List)
+		admin.PATCH("/users/:id/role", userHandler.UpdateRole)
+	}
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/grachmannico95/mileapp-test-be/pkg/jwks"
+)
+
+func RegisterWellKnownRoutes(router *gin.Engine, keySet *jwks.KeySet) {
+	router.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(200, keySet.JWKS())
+	})
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Attachment describes a file uploaded to a task. The content lives in the
+// blob store under StorageKey, Checksum is the hex encoded SHA-256 of it.
+type Attachment struct {
+	ID          primitive.ObjectID `bson:"_id" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Size        int64              `bson:"size" json:"size"`
+	ContentType string             `bson:"content_type" json:"content_type"`
+	Checksum    string             `bson:"checksum" json:"checksum"`
+	StorageKey  string             `bson:"storage_key" json:"-"`
+	UploadedBy  primitive.ObjectID `bson:"uploaded_by" json:"uploaded_by"`
+	UploadedAt  time.Time          `bson:"uploaded_at" json:"uploaded_at"`
+}
+
+func NewAttachment(taskID, uploadedBy primitive.ObjectID, name, contentType string) *Attachment {
+	id := primitive.NewObjectID()
+	return &Attachment{
+		ID:          id,
+		Name:        name,
+		ContentType: contentType,
+		StorageKey:  "tasks/" + taskID.Hex() + "/" + id.Hex(),
+		UploadedBy:  uploadedBy,
+		UploadedAt:  time.Now(),
+	}
+}
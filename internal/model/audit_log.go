@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditAction string
+
+const (
+	AuditActionLogin                     AuditAction = "auth.login"
+	AuditActionLoginFailed               AuditAction = "auth.login_failed"
+	AuditActionLogout                    AuditAction = "auth.logout"
+	AuditActionLogoutAll                 AuditAction = "auth.logout_all"
+	AuditActionSessionRevoke             AuditAction = "session.revoke"
+	AuditActionPersonalAccessTokenRevoke AuditAction = "personal_access_token.revoke"
+	AuditActionTaskCreate                AuditAction = "task.create"
+	AuditActionTaskUpdate                AuditAction = "task.update"
+	AuditActionTaskDelete                AuditAction = "task.delete"
+	AuditActionImpersonationStart        AuditAction = "impersonation.start"
+	AuditActionImpersonationStop         AuditAction = "impersonation.stop"
+	AuditActionImpersonationRequest      AuditAction = "impersonation.request"
+	AuditActionProjectCreate             AuditAction = "project.create"
+	AuditActionProjectUpdate             AuditAction = "project.update"
+	AuditActionProjectDelete             AuditAction = "project.delete"
+	AuditActionProjectMemberAdd          AuditAction = "project.member_add"
+	AuditActionProjectMemberUpdate       AuditAction = "project.member_update"
+	AuditActionProjectMemberRemove       AuditAction = "project.member_remove"
+	AuditActionLabelCreate               AuditAction = "label.create"
+	AuditActionLabelUpdate               AuditAction = "label.update"
+	AuditActionLabelDelete               AuditAction = "label.delete"
+	AuditActionCommentCreate             AuditAction = "comment.create"
+	AuditActionCommentUpdate             AuditAction = "comment.update"
+	AuditActionCommentDelete             AuditAction = "comment.delete"
+	AuditActionAttachmentUpload          AuditAction = "attachment.upload"
+	AuditActionAttachmentDelete          AuditAction = "attachment.delete"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditLog is a single security relevant event. Entries are only ever
+// inserted; ExpiresAt is set from the retention setting and left empty when
+// entries are kept forever. ImpersonatorID is the admin behind the request
+// when it was made with an impersonation token.
+type AuditLog struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ActorID        primitive.ObjectID `bson:"actor_id,omitempty" json:"actor_id"`
+	ImpersonatorID primitive.ObjectID `bson:"impersonator_id,omitempty" json:"impersonator_id"`
+	IP             string             `bson:"ip" json:"ip"`
+	UserAgent      string             `bson:"user_agent" json:"user_agent"`
+	Action         AuditAction        `bson:"action" json:"action"`
+	TargetType     string             `bson:"target_type,omitempty" json:"target_type,omitempty"`
+	TargetID       string             `bson:"target_id,omitempty" json:"target_id,omitempty"`
+	Outcome        AuditOutcome       `bson:"outcome" json:"outcome"`
+	Reason         string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	Metadata       map[string]string  `bson:"metadata,omitempty" json:"metadata,omitempty"`
+	ExpiresAt      *time.Time         `bson:"expires_at,omitempty" json:"-"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// NewAuditLog returns a successful event; actorID may be empty when the actor
+// is unknown, e.g. for a failed login with an unknown email address.
+func NewAuditLog(action AuditAction, actorID, targetType, targetID string) *AuditLog {
+	actor, _ := primitive.ObjectIDFromHex(actorID)
+	return &AuditLog{
+		ActorID:    actor,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Outcome:    AuditOutcomeSuccess,
+	}
+}
+
+// WithError marks the event as failed when err is not nil.
+func (l *AuditLog) WithError(err error) *AuditLog {
+	if err != nil {
+		l.Outcome = AuditOutcomeFailure
+		l.Reason = err.Error()
+	}
+	return l
+}
+
+func (l *AuditLog) WithMetadata(key, value string) *AuditLog {
+	if l.Metadata == nil {
+		l.Metadata = map[string]string{}
+	}
+	l.Metadata[key] = value
+	return l
+}
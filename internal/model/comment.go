@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Comment belongs to a task. Threads are a single level deep: a reply points
+// at a top-level comment through ParentID and cannot itself be replied to.
+// ProjectID is copied from the task so a project's comments can be removed
+// together with its tasks.
+type Comment struct {
+	ID        primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	TaskID    primitive.ObjectID  `bson:"task_id" json:"task_id"`
+	ProjectID *primitive.ObjectID `bson:"project_id,omitempty" json:"-"`
+	ParentID  *primitive.ObjectID `bson:"parent_id,omitempty" json:"parent_id,omitempty"`
+	AuthorID  primitive.ObjectID  `bson:"author_id" json:"author_id"`
+	Body      string              `bson:"body" json:"body"`
+	Edited    bool                `bson:"edited" json:"edited"`
+	EditedAt  *time.Time          `bson:"edited_at,omitempty" json:"edited_at,omitempty"`
+	CreatedAt time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time           `bson:"updated_at" json:"updated_at"`
+}
+
+func NewComment(task *Task, parentID *primitive.ObjectID, authorID primitive.ObjectID, body string) *Comment {
+	now := time.Now()
+	return &Comment{
+		TaskID:    task.ID,
+		ProjectID: task.ProjectID,
+		ParentID:  parentID,
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (c *Comment) IsReply() bool {
+	return c.ParentID != nil
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Invite struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Code      string             `bson:"code" json:"code"`
+	Email     string             `bson:"email,omitempty" json:"email,omitempty"`
+	CreatedBy primitive.ObjectID `bson:"created_by" json:"created_by"`
+	UsedBy    string             `bson:"used_by,omitempty" json:"used_by,omitempty"`
+	UsedAt    *time.Time         `bson:"used_at" json:"used_at,omitempty"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+func NewInvite(code, email string, createdBy primitive.ObjectID, expiry time.Duration) *Invite {
+	now := time.Now()
+	return &Invite{
+		Code:      code,
+		Email:     NormalizeEmail(email),
+		CreatedBy: createdBy,
+		ExpiresAt: now.Add(expiry),
+		CreatedAt: now,
+	}
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// LoginAttempt tracks failed logins for a single key, either an email address
+// or a client IP. It is kept apart from User so that unknown emails can be
+// throttled exactly like existing ones.
+type LoginAttempt struct {
+	Key           string     `bson:"_id" json:"key"`
+	Failures      int        `bson:"failures" json:"failures"`
+	LastFailureAt time.Time  `bson:"last_failure_at" json:"last_failure_at"`
+	LockedUntil   *time.Time `bson:"locked_until,omitempty" json:"locked_until,omitempty"`
+	ExpiresAt     time.Time  `bson:"expires_at" json:"expires_at"`
+}
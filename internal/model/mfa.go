@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// MFA holds a user's TOTP enrollment. Secret is set as soon as enrollment
+// starts but only takes effect once Enabled is flipped by a confirmed code.
+type MFA struct {
+	Enabled       bool       `bson:"enabled" json:"enabled"`
+	Secret        string     `bson:"secret" json:"-"`
+	RecoveryCodes []string   `bson:"recovery_codes" json:"-"`
+	LastUsedStep  int64      `bson:"last_used_step" json:"-"`
+	EnabledAt     *time.Time `bson:"enabled_at" json:"enabled_at,omitempty"`
+}
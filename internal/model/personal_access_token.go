@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PersonalAccessToken is a long-lived credential for scripts and CI. Only the
+// hash of the token is stored; Prefix keeps enough of it to be recognizable
+// in listings.
+type PersonalAccessToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Name      string             `bson:"name" json:"name"`
+	TokenHash string             `bson:"token_hash" json:"-"`
+	Prefix    string             `bson:"prefix" json:"prefix"`
+	Scopes    []string           `bson:"scopes" json:"scopes"`
+	// TokenVersion is the owner's token version at creation; the token stops
+	// working once the owner's sessions are revoked and the version moves on
+	TokenVersion int        `bson:"token_version" json:"-"`
+	LastUsedAt   *time.Time `bson:"last_used_at" json:"last_used_at,omitempty"`
+	LastUsedIP   string     `bson:"last_used_ip" json:"last_used_ip,omitempty"`
+	RevokedAt    *time.Time `bson:"revoked_at" json:"revoked_at,omitempty"`
+	ExpiresAt    time.Time  `bson:"expires_at" json:"expires_at"`
+	CreatedAt    time.Time  `bson:"created_at" json:"created_at"`
+}
+
+func NewPersonalAccessToken(userID primitive.ObjectID, tokenVersion int, name, tokenHash, prefix string, scopes []string, expiry time.Duration) *PersonalAccessToken {
+	now := time.Now()
+	return &PersonalAccessToken{
+		UserID:       userID,
+		Name:         name,
+		TokenHash:    tokenHash,
+		Prefix:       prefix,
+		Scopes:       scopes,
+		TokenVersion: tokenVersion,
+		ExpiresAt:    now.Add(expiry),
+		CreatedAt:    now,
+	}
+}
+
+func (t *PersonalAccessToken) IsActive() bool {
+	return t.RevokedAt == nil && time.Now().Before(t.ExpiresAt)
+}
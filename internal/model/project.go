@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ProjectRole string
+
+const (
+	ProjectRoleOwner  ProjectRole = "owner"
+	ProjectRoleEditor ProjectRole = "editor"
+	ProjectRoleViewer ProjectRole = "viewer"
+)
+
+func IsValidProjectRole(role string) bool {
+	switch ProjectRole(role) {
+	case ProjectRoleOwner, ProjectRoleEditor, ProjectRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanWriteTasks reports whether members with the role may create, change and
+// delete the project's tasks.
+func (r ProjectRole) CanWriteTasks() bool {
+	return r == ProjectRoleOwner || r == ProjectRoleEditor
+}
+
+// CanManage reports whether members with the role may change the project
+// itself and its members.
+func (r ProjectRole) CanManage() bool {
+	return r == ProjectRoleOwner
+}
+
+type ProjectMember struct {
+	UserID  primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Role    ProjectRole        `bson:"role" json:"role"`
+	AddedAt time.Time          `bson:"added_at" json:"added_at"`
+}
+
+// Project groups tasks and decides who may access them. Members are embedded
+// since a project only has a handful of them and they are always needed
+// together with the project.
+type Project struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name        string             `bson:"name" json:"name"`
+	Description string             `bson:"description" json:"description"`
+	CreatedBy   primitive.ObjectID `bson:"created_by" json:"created_by"`
+	Members     []ProjectMember    `bson:"members" json:"members"`
+	ArchivedAt  *time.Time         `bson:"archived_at,omitempty" json:"-"`
+	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// NewProject returns a project with its creator as the only owner.
+func NewProject(name, description string, createdBy primitive.ObjectID) *Project {
+	now := time.Now()
+	return &Project{
+		Name:        name,
+		Description: description,
+		CreatedBy:   createdBy,
+		Members: []ProjectMember{
+			{UserID: createdBy, Role: ProjectRoleOwner, AddedAt: now},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// MemberRole returns the user's role in the project and whether the user is a
+// member at all.
+func (p *Project) MemberRole(userID primitive.ObjectID) (ProjectRole, bool) {
+	for _, member := range p.Members {
+		if member.UserID == userID {
+			return member.Role, true
+		}
+	}
+	return "", false
+}
+
+func (p *Project) OwnerCount() int {
+	count := 0
+	for _, member := range p.Members {
+		if member.Role == ProjectRoleOwner {
+			count++
+		}
+	}
+	return count
+}
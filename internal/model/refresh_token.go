@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RefreshToken is a single link in a rotation chain. Every token issued from the
+// same login shares a FamilyID so that replaying a used token can revoke the chain.
+type RefreshToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	FamilyID  string             `bson:"family_id" json:"family_id"`
+	TokenHash string             `bson:"token_hash" json:"-"`
+	UsedAt    *time.Time         `bson:"used_at" json:"used_at,omitempty"`
+	RevokedAt *time.Time         `bson:"revoked_at" json:"revoked_at,omitempty"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+func NewRefreshToken(userID primitive.ObjectID, familyID, tokenHash string, expiry time.Duration) *RefreshToken {
+	now := time.Now()
+	return &RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(expiry),
+		CreatedAt: now,
+	}
+}
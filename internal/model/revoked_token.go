@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RevokedToken records an access token (by its jti) that must no longer be
+// accepted. It only needs to live until the token would have expired anyway.
+type RevokedToken struct {
+	ID        string             `bson:"_id" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expires_at"`
+	RevokedAt time.Time          `bson:"revoked_at" json:"revoked_at"`
+}
+
+func NewRevokedToken(tokenID string, userID primitive.ObjectID, expiresAt time.Time) *RevokedToken {
+	return &RevokedToken{
+		ID:        tokenID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		RevokedAt: time.Now(),
+	}
+}
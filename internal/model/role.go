@@ -0,0 +1,78 @@
+package model
+
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleViewer Role = "viewer"
+)
+
+type Permission string
+
+const (
+	PermissionTasksRead     Permission = "tasks:read"
+	PermissionTasksWrite    Permission = "tasks:write"
+	PermissionInvitesWrite  Permission = "invites:write"
+	PermissionUsersManage   Permission = "users:manage"
+	PermissionAuditRead     Permission = "audit:read"
+	PermissionImpersonate   Permission = "users:impersonate"
+	PermissionProjectsRead  Permission = "projects:read"
+	PermissionProjectsWrite Permission = "projects:write"
+	PermissionLabelsRead    Permission = "labels:read"
+	PermissionLabelsWrite   Permission = "labels:write"
+)
+
+// The label catalogue is shared by everyone and changing a label rewrites the
+// tags of every task, so only admins hold PermissionLabelsWrite.
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionTasksRead,
+		PermissionTasksWrite,
+		PermissionInvitesWrite,
+		PermissionUsersManage,
+		PermissionAuditRead,
+		PermissionImpersonate,
+		PermissionProjectsRead,
+		PermissionProjectsWrite,
+		PermissionLabelsRead,
+		PermissionLabelsWrite,
+	},
+	RoleMember: {
+		PermissionTasksRead,
+		PermissionTasksWrite,
+		PermissionProjectsRead,
+		PermissionProjectsWrite,
+		PermissionLabelsRead,
+	},
+	RoleViewer: {
+		PermissionTasksRead,
+		PermissionProjectsRead,
+		PermissionLabelsRead,
+	},
+}
+
+func IsValidPermission(permission string) bool {
+	for _, permissions := range rolePermissions {
+		for _, p := range permissions {
+			if p == Permission(permission) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[Role(role)]
+	return ok
+}
+
+func (r Role) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Session is a single login on one device. It lives as long as the refresh
+// token family issued at login and is referenced by the "sid" claim of every
+// access token issued for it.
+type Session struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"user_id"`
+	FamilyID   string             `bson:"family_id" json:"-"`
+	UserAgent  string             `bson:"user_agent" json:"user_agent"`
+	IP         string             `bson:"ip" json:"ip"`
+	LastSeenAt time.Time          `bson:"last_seen_at" json:"last_seen_at"`
+	RevokedAt  *time.Time         `bson:"revoked_at" json:"revoked_at,omitempty"`
+	ExpiresAt  time.Time          `bson:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+}
+
+func NewSession(userID primitive.ObjectID, familyID, userAgent, ip string, expiry time.Duration) *Session {
+	now := time.Now()
+	return &Session{
+		UserID:     userID,
+		FamilyID:   familyID,
+		UserAgent:  userAgent,
+		IP:         ip,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(expiry),
+		CreatedAt:  now,
+	}
+}
+
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
@@ -22,20 +22,47 @@ const (
 	TaskPriorityHigh   TaskPriority = "high"
 )
 
+// Task belongs to its creator (UserID) unless it was created in a project, in
+// which case the project's members decide who may access it. ArchivedAt is set
+// when its project was deleted with archiving enabled.
+//
+// Tags hold label names from the label catalogue. Attachments are only changed
+// through their own repository methods, never by Update.
+//
+// Assignees and CommentCount are not stored, the service loads them: the
+// profiles of AssigneeIDs and the number of comments including replies.
 type Task struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Title       string             `bson:"title" json:"title"`
-	Description string             `bson:"description" json:"description"`
-	Status      TaskStatus         `bson:"status" json:"status"`
-	Priority    int                `bson:"priority" json:"priority"`
-	DueDate     *time.Time         `bson:"due_date,omitempty" json:"due_date,omitempty"`
-	CreatedAt   time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updated_at"`
+	ID           primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	UserID       primitive.ObjectID   `bson:"user_id" json:"user_id"`
+	ProjectID    *primitive.ObjectID  `bson:"project_id,omitempty" json:"project_id,omitempty"`
+	AssigneeIDs  []primitive.ObjectID `bson:"assignee_ids" json:"assignee_ids,omitempty"`
+	Assignees    []TaskAssignee       `bson:"-" json:"-"`
+	CommentCount int64                `bson:"-" json:"-"`
+	Tags         []string             `bson:"tags" json:"tags"`
+	Attachments  []Attachment         `bson:"attachments,omitempty" json:"attachments,omitempty"`
+	Title        string               `bson:"title" json:"title"`
+	Description  string               `bson:"description" json:"description"`
+	Status       TaskStatus           `bson:"status" json:"status"`
+	Priority     int                  `bson:"priority" json:"priority"`
+	DueDate      *time.Time           `bson:"due_date,omitempty" json:"due_date,omitempty"`
+	ArchivedAt   *time.Time           `bson:"archived_at,omitempty" json:"-"`
+	CreatedAt    time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time            `bson:"updated_at" json:"updated_at"`
 }
 
-func NewTask(title, description string, status TaskStatus, priority TaskPriority, dueDate *time.Time) *Task {
+type TaskAssignee struct {
+	ID          primitive.ObjectID
+	Email       string
+	DisplayName string
+}
+
+func NewTask(userID primitive.ObjectID, projectID *primitive.ObjectID, assigneeIDs []primitive.ObjectID, tags []string, title, description string, status TaskStatus, priority TaskPriority, dueDate *time.Time) *Task {
 	now := time.Now()
 	return &Task{
+		UserID:      userID,
+		ProjectID:   projectID,
+		AssigneeIDs: assigneeIDs,
+		Tags:        tags,
 		Title:       title,
 		Description: description,
 		Status:      status,
@@ -46,6 +73,24 @@ func NewTask(title, description string, status TaskStatus, priority TaskPriority
 	}
 }
 
+func (t *Task) IsAssignee(userID primitive.ObjectID) bool {
+	for _, assigneeID := range t.AssigneeIDs {
+		if assigneeID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Task) FindAttachment(id primitive.ObjectID) *Attachment {
+	for i := range t.Attachments {
+		if t.Attachments[i].ID == id {
+			return &t.Attachments[i]
+		}
+	}
+	return nil
+}
+
 func IsValidStatus(status string) bool {
 	switch TaskStatus(status) {
 	case TaskStatusPending, TaskStatusInProgress, TaskStatusCompleted:
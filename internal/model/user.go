@@ -1,25 +1,53 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type User struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Email     string             `bson:"email" json:"email"`
-	Password  string             `bson:"password" json:"-"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Email           string             `bson:"email" json:"email"`
+	Password        string             `bson:"password" json:"-"`
+	Role            Role               `bson:"role" json:"role"`
+	DisplayName     string             `bson:"display_name" json:"display_name"`
+	Timezone        string             `bson:"timezone" json:"timezone"`
+	Locale          string             `bson:"locale" json:"locale"`
+	EmailVerifiedAt *time.Time         `bson:"email_verified_at" json:"email_verified_at,omitempty"`
+	MFA             MFA                `bson:"mfa" json:"-"`
+	TokenVersion    int                `bson:"token_version" json:"-"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// NormalizeEmail returns the form emails are stored and looked up in, so
+// addresses differing only in case belong to the same account.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
 }
 
 func NewUser(email, password string) *User {
 	now := time.Now()
 	return &User{
-		Email:     email,
+		Email:     NormalizeEmail(email),
 		Password:  password,
+		Role:      RoleMember,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 }
+
+// GetRole returns the user's role, treating accounts created before roles
+// existed as members.
+func (u *User) GetRole() Role {
+	if u.Role == "" {
+		return RoleMember
+	}
+	return u.Role
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditLogFilters struct {
+	ActorID        primitive.ObjectID
+	ImpersonatorID primitive.ObjectID
+	Action         string
+	TargetType     string
+	TargetID       string
+	Outcome        string
+	From           string
+	To             string
+	Page           int
+	Limit          int
+}
+
+// AuditLogRepository is append-only: entries can be added and read but never
+// changed, they only disappear through the retention TTL.
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *model.AuditLog) error
+	Find(ctx context.Context, filters AuditLogFilters) ([]model.AuditLog, int64, error)
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type auditLogRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewAuditLogRepository(db *mongo.Database) AuditLogRepository {
+	return &auditLogRepositoryImpl{
+		collection: db.Collection("audit_logs"),
+	}
+}
+
+func (r *auditLogRepositoryImpl) Create(ctx context.Context, entry *model.AuditLog) error {
+	entry.ID = primitive.NewObjectID()
+
+	_, err := r.collection.InsertOne(ctx, entry)
+	return err
+}
+
+func (r *auditLogRepositoryImpl) Find(ctx context.Context, filters AuditLogFilters) ([]model.AuditLog, int64, error) {
+	query := bson.M{}
+
+	if !filters.ActorID.IsZero() {
+		query["actor_id"] = filters.ActorID
+	}
+
+	if !filters.ImpersonatorID.IsZero() {
+		query["impersonator_id"] = filters.ImpersonatorID
+	}
+
+	if filters.Action != "" {
+		query["action"] = filters.Action
+	}
+
+	if filters.TargetType != "" {
+		query["target_type"] = filters.TargetType
+	}
+
+	if filters.TargetID != "" {
+		query["target_id"] = filters.TargetID
+	}
+
+	if filters.Outcome != "" {
+		query["outcome"] = filters.Outcome
+	}
+
+	if filters.From != "" || filters.To != "" {
+		dateQuery := bson.M{}
+
+		layout := "2006-01-02"
+		if filters.From != "" {
+			fromDate, err := time.Parse(layout, filters.From)
+			if err == nil {
+				dateQuery["$gte"] = fromDate
+			}
+		}
+
+		// the "to" day is included as a whole
+		if filters.To != "" {
+			toDate, err := time.Parse(layout, filters.To)
+			if err == nil {
+				dateQuery["$lt"] = toDate.AddDate(0, 0, 1)
+			}
+		}
+
+		if len(dateQuery) > 0 {
+			query["created_at"] = dateQuery
+		}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page := filters.Page
+	if page < 1 {
+		page = 1
+	}
+
+	limit := filters.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	skip := (page - 1) * limit
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []model.AuditLog
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, 0, err
+	}
+
+	if entries == nil {
+		entries = []model.AuditLog{}
+	}
+
+	return entries, total, nil
+}
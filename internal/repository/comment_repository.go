@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CommentRepository pages through the top-level comments of a task, oldest
+// first; replies are loaded separately for the comments of a page.
+type CommentRepository interface {
+	Create(ctx context.Context, comment *model.Comment) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Comment, error)
+	FindTopLevel(ctx context.Context, taskID primitive.ObjectID, page, limit int) ([]model.Comment, int64, error)
+	FindReplies(ctx context.Context, parentIDs []primitive.ObjectID) ([]model.Comment, error)
+	CountByTasks(ctx context.Context, taskIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error)
+	Update(ctx context.Context, comment *model.Comment) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByTask(ctx context.Context, taskID primitive.ObjectID) error
+	DeleteByProject(ctx context.Context, projectID primitive.ObjectID) error
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type commentRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewCommentRepository(db *mongo.Database) CommentRepository {
+	return &commentRepositoryImpl{
+		collection: db.Collection("comments"),
+	}
+}
+
+func (r *commentRepositoryImpl) Create(ctx context.Context, comment *model.Comment) error {
+	comment.ID = primitive.NewObjectID()
+
+	_, err := r.collection.InsertOne(ctx, comment)
+	return err
+}
+
+func (r *commentRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Comment, error) {
+	var comment model.Comment
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&comment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+func (r *commentRepositoryImpl) FindTopLevel(ctx context.Context, taskID primitive.ObjectID, page, limit int) ([]model.Comment, int64, error) {
+	filter := bson.M{"task_id": taskID, "parent_id": bson.M{"$exists": false}}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit))
+
+	comments, err := r.find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (r *commentRepositoryImpl) FindReplies(ctx context.Context, parentIDs []primitive.ObjectID) ([]model.Comment, error) {
+	filter := bson.M{"parent_id": bson.M{"$in": parentIDs}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, filter, findOptions)
+}
+
+func (r *commentRepositoryImpl) find(ctx context.Context, filter bson.M, findOptions *options.FindOptions) ([]model.Comment, error) {
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var comments []model.Comment
+	if err := cursor.All(ctx, &comments); err != nil {
+		return nil, err
+	}
+
+	if comments == nil {
+		comments = []model.Comment{}
+	}
+
+	return comments, nil
+}
+
+// CountByTasks counts comments and replies per task; tasks without comments
+// are missing from the result.
+func (r *commentRepositoryImpl) CountByTasks(ctx context.Context, taskIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"task_id": bson.M{"$in": taskIDs}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$task_id", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		TaskID primitive.ObjectID `bson:"_id"`
+		Count  int64              `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[primitive.ObjectID]int64, len(results))
+	for _, result := range results {
+		counts[result.TaskID] = result.Count
+	}
+
+	return counts, nil
+}
+
+func (r *commentRepositoryImpl) Update(ctx context.Context, comment *model.Comment) error {
+	comment.UpdatedAt = time.Now()
+
+	update := bson.M{"$set": bson.M{
+		"body":       comment.Body,
+		"edited":     comment.Edited,
+		"edited_at":  comment.EditedAt,
+		"updated_at": comment.UpdatedAt,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": comment.ID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("comment not found")
+	}
+
+	return nil
+}
+
+// Delete removes the comment together with its replies.
+func (r *commentRepositoryImpl) Delete(ctx context.Context, id primitive.ObjectID) error {
+	result, err := r.collection.DeleteMany(ctx, bson.M{"$or": []bson.M{{"_id": id}, {"parent_id": id}}})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("comment not found")
+	}
+
+	return nil
+}
+
+func (r *commentRepositoryImpl) DeleteByTask(ctx context.Context, taskID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"task_id": taskID})
+	return err
+}
+
+func (r *commentRepositoryImpl) DeleteByProject(ctx context.Context, projectID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
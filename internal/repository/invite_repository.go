@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type InviteRepository interface {
+	Create(ctx context.Context, invite *model.Invite) error
+	Claim(ctx context.Context, code, email string) (*model.Invite, error)
+	Release(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type inviteRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewInviteRepository(db *mongo.Database) InviteRepository {
+	return &inviteRepositoryImpl{
+		collection: db.Collection("invites"),
+	}
+}
+
+func (r *inviteRepositoryImpl) Create(ctx context.Context, invite *model.Invite) error {
+	invite.ID = primitive.NewObjectID()
+	invite.CreatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, invite)
+	return err
+}
+
+// Claim atomically marks an unused, unexpired invite as used by email so the
+// same code can never be redeemed twice. It returns nil when no such invite exists.
+func (r *inviteRepositoryImpl) Claim(ctx context.Context, code, email string) (*model.Invite, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"code":       code,
+		"used_at":    nil,
+		"expires_at": bson.M{"$gt": now},
+		"$or": []bson.M{
+			{"email": bson.M{"$exists": false}},
+			{"email": email},
+		},
+	}
+	update := bson.M{"$set": bson.M{"used_at": now, "used_by": email}}
+
+	var invite model.Invite
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&invite)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &invite, nil
+}
+
+// Release makes a claimed invite usable again, e.g. when creating the account failed.
+func (r *inviteRepositoryImpl) Release(ctx context.Context, id primitive.ObjectID) error {
+	update := bson.M{
+		"$set":   bson.M{"used_at": nil},
+		"$unset": bson.M{"used_by": ""},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("invite not found")
+	}
+
+	return nil
+}
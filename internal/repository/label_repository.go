@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LabelRepository interface {
+	Create(ctx context.Context, label *model.Label) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Label, error)
+	FindByNames(ctx context.Context, names []string) ([]model.Label, error)
+	FindAll(ctx context.Context) ([]model.Label, error)
+	Update(ctx context.Context, label *model.Label) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type labelRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewLabelRepository(db *mongo.Database) LabelRepository {
+	return &labelRepositoryImpl{
+		collection: db.Collection("labels"),
+	}
+}
+
+func (r *labelRepositoryImpl) Create(ctx context.Context, label *model.Label) error {
+	label.ID = primitive.NewObjectID()
+
+	_, err := r.collection.InsertOne(ctx, label)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("label already exists")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *labelRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Label, error) {
+	var label model.Label
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&label)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &label, nil
+}
+
+func (r *labelRepositoryImpl) FindByNames(ctx context.Context, names []string) ([]model.Label, error) {
+	return r.find(ctx, bson.M{"name": bson.M{"$in": names}})
+}
+
+func (r *labelRepositoryImpl) FindAll(ctx context.Context) ([]model.Label, error) {
+	return r.find(ctx, bson.M{})
+}
+
+func (r *labelRepositoryImpl) find(ctx context.Context, filter bson.M) ([]model.Label, error) {
+	findOptions := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var labels []model.Label
+	if err := cursor.All(ctx, &labels); err != nil {
+		return nil, err
+	}
+
+	if labels == nil {
+		labels = []model.Label{}
+	}
+
+	return labels, nil
+}
+
+func (r *labelRepositoryImpl) Update(ctx context.Context, label *model.Label) error {
+	label.UpdatedAt = time.Now()
+
+	update := bson.M{"$set": bson.M{
+		"name":       label.Name,
+		"color":      label.Color,
+		"updated_at": label.UpdatedAt,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": label.ID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New("label already exists")
+		}
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("label not found")
+	}
+
+	return nil
+}
+
+func (r *labelRepositoryImpl) Delete(ctx context.Context, id primitive.ObjectID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("label not found")
+	}
+
+	return nil
+}
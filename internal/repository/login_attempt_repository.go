@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type LoginAttemptRepository interface {
+	FindByKey(ctx context.Context, key string) (*model.LoginAttempt, error)
+	RecordFailure(ctx context.Context, key string, window time.Duration) (*model.LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
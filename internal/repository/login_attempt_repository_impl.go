@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type loginAttemptRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewLoginAttemptRepository(db *mongo.Database) LoginAttemptRepository {
+	return &loginAttemptRepositoryImpl{
+		collection: db.Collection("login_attempts"),
+	}
+}
+
+func (r *loginAttemptRepositoryImpl) FindByKey(ctx context.Context, key string) (*model.LoginAttempt, error) {
+	var attempt model.LoginAttempt
+	err := r.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&attempt)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+// RecordFailure atomically counts a failed attempt. Failures older than window
+// are forgotten, so the counter starts over instead of growing forever.
+func (r *loginAttemptRepositoryImpl) RecordFailure(ctx context.Context, key string, window time.Duration) (*model.LoginAttempt, error) {
+	now := time.Now()
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"failures": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{"$expires_at", now}},
+				bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$failures", 0}}, 1}},
+				1,
+			}},
+			"last_failure_at": now,
+			"expires_at": bson.M{"$max": bson.A{
+				now.Add(window),
+				bson.M{"$ifNull": bson.A{"$locked_until", now}},
+			}},
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var attempt model.LoginAttempt
+	if err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": key}, update, opts).Decode(&attempt); err != nil {
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+// Lock blocks the key until the given time and clears its failure counter so
+// that the key starts fresh once the lock has expired.
+func (r *loginAttemptRepositoryImpl) Lock(ctx context.Context, key string, until time.Time) error {
+	update := bson.M{"$set": bson.M{
+		"failures":     0,
+		"locked_until": until,
+		"expires_at":   until,
+	}}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, update)
+	return err
+}
+
+func (r *loginAttemptRepositoryImpl) Reset(ctx context.Context, key string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
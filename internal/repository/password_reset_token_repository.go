@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, token *model.PasswordResetToken) error
+	FindValid(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
+	Consume(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
+	InvalidateForUser(ctx context.Context, userID primitive.ObjectID) error
+}
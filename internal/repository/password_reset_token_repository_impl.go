@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type passwordResetTokenRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewPasswordResetTokenRepository(db *mongo.Database) PasswordResetTokenRepository {
+	return &passwordResetTokenRepositoryImpl{
+		collection: db.Collection("password_reset_tokens"),
+	}
+}
+
+func (r *passwordResetTokenRepositoryImpl) Create(ctx context.Context, token *model.PasswordResetToken) error {
+	token.ID = primitive.NewObjectID()
+	token.CreatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, token)
+	return err
+}
+
+// FindValid returns the token if it is unused and unexpired, without using it
+// up. It returns nil when no such token exists.
+func (r *passwordResetTokenRepositoryImpl) FindValid(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	filter := bson.M{
+		"token_hash": tokenHash,
+		"used_at":    nil,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+
+	var token model.PasswordResetToken
+	err := r.collection.FindOne(ctx, filter).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Consume atomically marks an unused, unexpired token as used so it can only be
+// redeemed once. It returns nil when no such token exists.
+func (r *passwordResetTokenRepositoryImpl) Consume(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"token_hash": tokenHash,
+		"used_at":    nil,
+		"expires_at": bson.M{"$gt": now},
+	}
+	update := bson.M{"$set": bson.M{"used_at": now}}
+
+	var token model.PasswordResetToken
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *passwordResetTokenRepositoryImpl) InvalidateForUser(ctx context.Context, userID primitive.ObjectID) error {
+	filter := bson.M{"user_id": userID, "used_at": nil}
+	update := bson.M{"$set": bson.M{"used_at": time.Now()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *model.PersonalAccessToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error)
+	FindByUser(ctx context.Context, userID primitive.ObjectID) ([]model.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID, id primitive.ObjectID) error
+	UpdateLastUsed(ctx context.Context, id primitive.ObjectID, ip string, usedAt time.Time) error
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type personalAccessTokenRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewPersonalAccessTokenRepository(db *mongo.Database) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepositoryImpl{
+		collection: db.Collection("personal_access_tokens"),
+	}
+}
+
+func (r *personalAccessTokenRepositoryImpl) Create(ctx context.Context, token *model.PersonalAccessToken) error {
+	token.ID = primitive.NewObjectID()
+	token.CreatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, token)
+	return err
+}
+
+func (r *personalAccessTokenRepositoryImpl) FindByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error) {
+	var token model.PersonalAccessToken
+	err := r.collection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *personalAccessTokenRepositoryImpl) FindByUser(ctx context.Context, userID primitive.ObjectID) ([]model.PersonalAccessToken, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tokens []model.PersonalAccessToken
+	if err := cursor.All(ctx, &tokens); err != nil {
+		return nil, err
+	}
+
+	if tokens == nil {
+		tokens = []model.PersonalAccessToken{}
+	}
+
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepositoryImpl) Revoke(ctx context.Context, userID, id primitive.ObjectID) error {
+	filter := bson.M{"_id": id, "user_id": userID, "revoked_at": nil}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now()}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("personal access token not found")
+	}
+
+	return nil
+}
+
+func (r *personalAccessTokenRepositoryImpl) UpdateLastUsed(ctx context.Context, id primitive.ObjectID, ip string, usedAt time.Time) error {
+	update := bson.M{"$set": bson.M{"last_used_at": usedAt, "last_used_ip": ip}}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ProjectRepository only ever returns projects that have not been archived.
+type ProjectRepository interface {
+	Create(ctx context.Context, project *model.Project) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Project, error)
+	FindByMember(ctx context.Context, userID primitive.ObjectID) ([]model.Project, error)
+	// Update writes the project's name and description. Members are only ever
+	// changed through the member methods below, which apply their change in a
+	// single atomic update so concurrent changes are never lost.
+	Update(ctx context.Context, project *model.Project) error
+	// AddMember, UpdateMemberRole and RemoveMember return the updated project,
+	// or nil when the project no longer allows the change: the user is already
+	// a member, is not a member, or is the project's last owner.
+	AddMember(ctx context.Context, id primitive.ObjectID, member model.ProjectMember) (*model.Project, error)
+	UpdateMemberRole(ctx context.Context, id, userID primitive.ObjectID, role model.ProjectRole) (*model.Project, error)
+	RemoveMember(ctx context.Context, id, userID primitive.ObjectID) (*model.Project, error)
+	Archive(ctx context.Context, id primitive.ObjectID) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type projectRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewProjectRepository(db *mongo.Database) ProjectRepository {
+	return &projectRepositoryImpl{
+		collection: db.Collection("projects"),
+	}
+}
+
+func (r *projectRepositoryImpl) Create(ctx context.Context, project *model.Project) error {
+	project.ID = primitive.NewObjectID()
+
+	_, err := r.collection.InsertOne(ctx, project)
+	return err
+}
+
+func (r *projectRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Project, error) {
+	var project model.Project
+	err := r.collection.FindOne(ctx, bson.M{"_id": id, "archived_at": bson.M{"$exists": false}}).Decode(&project)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+func (r *projectRepositoryImpl) FindByMember(ctx context.Context, userID primitive.ObjectID) ([]model.Project, error) {
+	filter := bson.M{"members.user_id": userID, "archived_at": bson.M{"$exists": false}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var projects []model.Project
+	if err := cursor.All(ctx, &projects); err != nil {
+		return nil, err
+	}
+
+	if projects == nil {
+		projects = []model.Project{}
+	}
+
+	return projects, nil
+}
+
+func (r *projectRepositoryImpl) Update(ctx context.Context, project *model.Project) error {
+	project.UpdatedAt = time.Now()
+
+	filter := bson.M{"_id": project.ID, "archived_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{
+		"name":        project.Name,
+		"description": project.Description,
+		"updated_at":  project.UpdatedAt,
+	}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("project not found")
+	}
+
+	return nil
+}
+
+func (r *projectRepositoryImpl) AddMember(ctx context.Context, id primitive.ObjectID, member model.ProjectMember) (*model.Project, error) {
+	filter := bson.M{
+		"_id":             id,
+		"archived_at":     bson.M{"$exists": false},
+		"members.user_id": bson.M{"$ne": member.UserID},
+	}
+	update := bson.M{
+		"$push": bson.M{"members": member},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return r.findOneAndUpdate(ctx, filter, update)
+}
+
+func (r *projectRepositoryImpl) UpdateMemberRole(ctx context.Context, id, userID primitive.ObjectID, role model.ProjectRole) (*model.Project, error) {
+	filter := bson.M{
+		"_id":             id,
+		"archived_at":     bson.M{"$exists": false},
+		"members.user_id": userID,
+	}
+	if role != model.ProjectRoleOwner {
+		filter["$or"] = keepsAnotherOwner(userID)
+	}
+	update := bson.M{"$set": bson.M{
+		"members.$[member].role": role,
+		"updated_at":             time.Now(),
+	}}
+	updateOptions := options.FindOneAndUpdate().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"member.user_id": userID}},
+	})
+
+	return r.findOneAndUpdate(ctx, filter, update, updateOptions)
+}
+
+func (r *projectRepositoryImpl) RemoveMember(ctx context.Context, id, userID primitive.ObjectID) (*model.Project, error) {
+	filter := bson.M{
+		"_id":             id,
+		"archived_at":     bson.M{"$exists": false},
+		"members.user_id": userID,
+		"$or":             keepsAnotherOwner(userID),
+	}
+	update := bson.M{
+		"$pull": bson.M{"members": bson.M{"user_id": userID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	return r.findOneAndUpdate(ctx, filter, update)
+}
+
+// keepsAnotherOwner matches projects that still have an owner once the user
+// stops being one: either the user is not an owner or somebody else is.
+func keepsAnotherOwner(userID primitive.ObjectID) bson.A {
+	return bson.A{
+		bson.M{"members": bson.M{"$elemMatch": bson.M{"user_id": userID, "role": bson.M{"$ne": model.ProjectRoleOwner}}}},
+		bson.M{"members": bson.M{"$elemMatch": bson.M{"user_id": bson.M{"$ne": userID}, "role": model.ProjectRoleOwner}}},
+	}
+}
+
+func (r *projectRepositoryImpl) findOneAndUpdate(ctx context.Context, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*model.Project, error) {
+	opts = append(opts, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	var project model.Project
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts...).Decode(&project)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &project, nil
+}
+
+func (r *projectRepositoryImpl) Archive(ctx context.Context, id primitive.ObjectID) error {
+	now := time.Now()
+
+	filter := bson.M{"_id": id, "archived_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"archived_at": now, "updated_at": now}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("project not found")
+	}
+
+	return nil
+}
+
+func (r *projectRepositoryImpl) Delete(ctx context.Context, id primitive.ObjectID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New("project not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type refreshTokenRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewRefreshTokenRepository(db *mongo.Database) RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{
+		collection: db.Collection("refresh_tokens"),
+	}
+}
+
+func (r *refreshTokenRepositoryImpl) Create(ctx context.Context, token *model.RefreshToken) error {
+	token.ID = primitive.NewObjectID()
+	token.CreatedAt = time.Now()
+
+	_, err := r.collection.InsertOne(ctx, token)
+	return err
+}
+
+func (r *refreshTokenRepositoryImpl) FindByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	err := r.collection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// MarkUsed flags the token as consumed. It reports false when the token had
+// already been used, which means a concurrent request won the rotation.
+func (r *refreshTokenRepositoryImpl) MarkUsed(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	filter := bson.M{"_id": id, "used_at": nil}
+	update := bson.M{"$set": bson.M{"used_at": time.Now()}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string) error {
+	filter := bson.M{"family_id": familyID, "revoked_at": nil}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeAllForUser(ctx context.Context, userID primitive.ObjectID) error {
+	filter := bson.M{"user_id": userID, "revoked_at": nil}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
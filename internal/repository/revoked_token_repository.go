@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+)
+
+type RevokedTokenRepository interface {
+	Create(ctx context.Context, token *model.RevokedToken) error
+	Exists(ctx context.Context, tokenID string) (bool, error)
+}
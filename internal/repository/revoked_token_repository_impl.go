@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type revokedTokenRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewRevokedTokenRepository(db *mongo.Database) RevokedTokenRepository {
+	return &revokedTokenRepositoryImpl{
+		collection: db.Collection("revoked_tokens"),
+	}
+}
+
+func (r *revokedTokenRepositoryImpl) Create(ctx context.Context, token *model.RevokedToken) error {
+	_, err := r.collection.InsertOne(ctx, token)
+	if err != nil && mongo.IsDuplicateKeyError(err) {
+		// already revoked
+		return nil
+	}
+	return err
+}
+
+func (r *revokedTokenRepositoryImpl) Exists(ctx context.Context, tokenID string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": tokenID})
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Session, error)
+	FindByFamily(ctx context.Context, familyID string) (*model.Session, error)
+	FindActiveByUser(ctx context.Context, userID primitive.ObjectID) ([]model.Session, error)
+	Revoke(ctx context.Context, userID, id primitive.ObjectID) (*model.Session, error)
+	RevokeAllForUser(ctx context.Context, userID primitive.ObjectID) error
+	Touch(ctx context.Context, id primitive.ObjectID, ip string, seenAt, expiresAt time.Time) error
+}
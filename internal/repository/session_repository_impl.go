@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type sessionRepositoryImpl struct {
+	collection *mongo.Collection
+}
+
+func NewSessionRepository(db *mongo.Database) SessionRepository {
+	return &sessionRepositoryImpl{
+		collection: db.Collection("sessions"),
+	}
+}
+
+func (r *sessionRepositoryImpl) Create(ctx context.Context, session *model.Session) error {
+	session.ID = primitive.NewObjectID()
+
+	_, err := r.collection.InsertOne(ctx, session)
+	return err
+}
+
+func (r *sessionRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Session, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *sessionRepositoryImpl) FindByFamily(ctx context.Context, familyID string) (*model.Session, error) {
+	return r.findOne(ctx, bson.M{"family_id": familyID})
+}
+
+func (r *sessionRepositoryImpl) findOne(ctx context.Context, filter bson.M) (*model.Session, error) {
+	var session model.Session
+	err := r.collection.FindOne(ctx, filter).Decode(&session)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepositoryImpl) FindActiveByUser(ctx context.Context, userID primitive.ObjectID) ([]model.Session, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"revoked_at": nil,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "last_seen_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []model.Session
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, err
+	}
+
+	if sessions == nil {
+		sessions = []model.Session{}
+	}
+
+	return sessions, nil
+}
+
+// Revoke marks an active session of the user as revoked and returns it, or nil
+// when there is no such session.
+func (r *sessionRepositoryImpl) Revoke(ctx context.Context, userID, id primitive.ObjectID) (*model.Session, error) {
+	filter := bson.M{"_id": id, "user_id": userID, "revoked_at": nil}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now()}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var session model.Session
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&session)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepositoryImpl) RevokeAllForUser(ctx context.Context, userID primitive.ObjectID) error {
+	filter := bson.M{"user_id": userID, "revoked_at": nil}
+	update := bson.M{"$set": bson.M{"revoked_at": time.Now()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+// Touch records activity on a session. The expiry is only ever pushed back.
+func (r *sessionRepositoryImpl) Touch(ctx context.Context, id primitive.ObjectID, ip string, seenAt, expiresAt time.Time) error {
+	update := bson.M{
+		"$set": bson.M{"last_seen_at": seenAt, "ip": ip},
+		"$max": bson.M{"expires_at": expiresAt},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
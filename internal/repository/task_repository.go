@@ -7,16 +7,29 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// TaskFilters selects the tasks a user created (UserID), the tasks of a
+// project (ProjectID) or the tasks assigned to a user (AssigneeID), or their
+// intersection when several are set. Tags match tasks carrying any of the
+// tags, or all of them when TagsMatch is "all". Archived tasks are never
+// returned.
 type TaskFilters struct {
-	Status      string
-	Priority    string
-	Search      string
-	DueDateFrom string
-	DueDateTo   string
-	SortBy      string
-	SortOrder   string
-	Page        int
-	Limit       int
+	UserID     primitive.ObjectID
+	ProjectID  primitive.ObjectID
+	AssigneeID primitive.ObjectID
+	// MemberProjectIDs, when non-nil, limits the results to personal tasks and
+	// tasks in these projects
+	MemberProjectIDs []primitive.ObjectID
+	Tags             []string
+	TagsMatch        string
+	Status           string
+	Priority         string
+	Search           string
+	DueDateFrom      string
+	DueDateTo        string
+	SortBy           string
+	SortOrder        string
+	Page             int
+	Limit            int
 }
 
 type TaskRepository interface {
@@ -24,5 +37,13 @@ type TaskRepository interface {
 	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Task, error)
 	Find(ctx context.Context, filters TaskFilters) ([]model.Task, int64, error)
 	Update(ctx context.Context, task *model.Task) error
-	Delete(ctx context.Context, id primitive.ObjectID) error
+	Delete(ctx context.Context, userID, id primitive.ObjectID) error
+	ArchiveByProject(ctx context.Context, projectID primitive.ObjectID) error
+	DeleteByProject(ctx context.Context, projectID primitive.ObjectID) error
+	RemoveAssigneeFromProject(ctx context.Context, projectID, userID primitive.ObjectID) error
+	RenameTag(ctx context.Context, oldName, newName string) error
+	RemoveTag(ctx context.Context, name string) error
+	AddAttachment(ctx context.Context, taskID primitive.ObjectID, attachment *model.Attachment, maxPerTask int) error
+	RemoveAttachment(ctx context.Context, taskID, attachmentID primitive.ObjectID) error
+	FindStorageKeysByProject(ctx context.Context, projectID primitive.ObjectID) ([]string, error)
 }
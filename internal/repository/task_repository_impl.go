@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
@@ -31,9 +32,10 @@ func (r *taskRepositoryImpl) Create(ctx context.Context, task *model.Task) error
 	return err
 }
 
+// FindByID does not check access, callers decide who may see the task.
 func (r *taskRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Task, error) {
 	var task model.Task
-	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&task)
+	err := r.collection.FindOne(ctx, bson.M{"_id": id, "archived_at": bson.M{"$exists": false}}).Decode(&task)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -45,7 +47,36 @@ func (r *taskRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID
 }
 
 func (r *taskRepositoryImpl) Find(ctx context.Context, filters TaskFilters) ([]model.Task, int64, error) {
-	query := bson.M{}
+	query := bson.M{"archived_at": bson.M{"$exists": false}}
+
+	if !filters.UserID.IsZero() {
+		query["user_id"] = filters.UserID
+	}
+
+	if !filters.ProjectID.IsZero() {
+		query["project_id"] = filters.ProjectID
+	}
+
+	if !filters.AssigneeID.IsZero() {
+		query["assignee_ids"] = filters.AssigneeID
+	}
+
+	if filters.MemberProjectIDs != nil {
+		// a nil entry matches tasks without a project
+		projectIDs := []interface{}{nil}
+		for _, id := range filters.MemberProjectIDs {
+			projectIDs = append(projectIDs, id)
+		}
+		query["project_id"] = bson.M{"$in": projectIDs}
+	}
+
+	if len(filters.Tags) > 0 {
+		if filters.TagsMatch == "all" {
+			query["tags"] = bson.M{"$all": filters.Tags}
+		} else {
+			query["tags"] = bson.M{"$in": filters.Tags}
+		}
+	}
 
 	if filters.Status != "" {
 		query["status"] = filters.Status
@@ -135,11 +166,26 @@ func (r *taskRepositoryImpl) Find(ctx context.Context, filters TaskFilters) ([]m
 	return tasks, total, nil
 }
 
+// Update writes the editable fields only, so attachments added in the meantime
+// are kept.
 func (r *taskRepositoryImpl) Update(ctx context.Context, task *model.Task) error {
 	task.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": task.ID}
-	update := bson.M{"$set": task}
+	fields := bson.M{
+		"assignee_ids": task.AssigneeIDs,
+		"tags":         task.Tags,
+		"title":        task.Title,
+		"description":  task.Description,
+		"status":       task.Status,
+		"priority":     task.Priority,
+		"updated_at":   task.UpdatedAt,
+	}
+	if task.DueDate != nil {
+		fields["due_date"] = task.DueDate
+	}
+
+	filter := bson.M{"_id": task.ID, "user_id": task.UserID}
+	update := bson.M{"$set": fields}
 
 	result, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -153,8 +199,8 @@ func (r *taskRepositoryImpl) Update(ctx context.Context, task *model.Task) error
 	return nil
 }
 
-func (r *taskRepositoryImpl) Delete(ctx context.Context, id primitive.ObjectID) error {
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+func (r *taskRepositoryImpl) Delete(ctx context.Context, userID, id primitive.ObjectID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id, "user_id": userID})
 	if err != nil {
 		return err
 	}
@@ -165,3 +211,128 @@ func (r *taskRepositoryImpl) Delete(ctx context.Context, id primitive.ObjectID)
 
 	return nil
 }
+
+func (r *taskRepositoryImpl) ArchiveByProject(ctx context.Context, projectID primitive.ObjectID) error {
+	now := time.Now()
+
+	filter := bson.M{"project_id": projectID, "archived_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"archived_at": now, "updated_at": now}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (r *taskRepositoryImpl) DeleteByProject(ctx context.Context, projectID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"project_id": projectID})
+	return err
+}
+
+func (r *taskRepositoryImpl) RemoveAssigneeFromProject(ctx context.Context, projectID, userID primitive.ObjectID) error {
+	filter := bson.M{"project_id": projectID, "assignee_ids": userID}
+	update := bson.M{
+		"$pull": bson.M{"assignee_ids": userID},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+// RenameTag and RemoveTag rewrite the tag on every task, matching the scope of
+// the label catalogue, which is shared by all users and only managed by admins.
+func (r *taskRepositoryImpl) RenameTag(ctx context.Context, oldName, newName string) error {
+	filter := bson.M{"tags": oldName}
+	update := bson.M{"$set": bson.M{"tags.$": newName, "updated_at": time.Now()}}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+func (r *taskRepositoryImpl) RemoveTag(ctx context.Context, name string) error {
+	filter := bson.M{"tags": name}
+	update := bson.M{
+		"$pull": bson.M{"tags": name},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	_, err := r.collection.UpdateMany(ctx, filter, update)
+	return err
+}
+
+// AddAttachment only pushes while the task holds fewer than maxPerTask
+// attachments, so concurrent uploads cannot go past the limit.
+func (r *taskRepositoryImpl) AddAttachment(ctx context.Context, taskID primitive.ObjectID, attachment *model.Attachment, maxPerTask int) error {
+	filter := bson.M{
+		"_id":         taskID,
+		"archived_at": bson.M{"$exists": false},
+		fmt.Sprintf("attachments.%d", maxPerTask-1): bson.M{"$exists": false},
+	}
+	update := bson.M{
+		"$push": bson.M{"attachments": attachment},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": taskID, "archived_at": bson.M{"$exists": false}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.New("too many attachments")
+		}
+		return errors.New("task not found")
+	}
+
+	return nil
+}
+
+func (r *taskRepositoryImpl) RemoveAttachment(ctx context.Context, taskID, attachmentID primitive.ObjectID) error {
+	filter := bson.M{"_id": taskID, "attachments._id": attachmentID}
+	update := bson.M{
+		"$pull": bson.M{"attachments": bson.M{"_id": attachmentID}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("attachment not found")
+	}
+
+	return nil
+}
+
+// FindStorageKeysByProject returns the blob keys of every attachment in the
+// project, archived tasks included.
+func (r *taskRepositoryImpl) FindStorageKeysByProject(ctx context.Context, projectID primitive.ObjectID) ([]string, error) {
+	filter := bson.M{"project_id": projectID, "attachments.0": bson.M{"$exists": true}}
+	findOptions := options.Find().SetProjection(bson.M{"attachments.storage_key": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tasks []model.Task
+	if err := cursor.All(ctx, &tasks); err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for _, task := range tasks {
+		for _, attachment := range task.Attachments {
+			keys = append(keys, attachment.StorageKey)
+		}
+	}
+
+	return keys, nil
+}
@@ -2,14 +2,32 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type UserFilters struct {
+	Role   string
+	Search string
+	Page   int
+	Limit  int
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User) error
 	FindByEmail(ctx context.Context, email string) (*model.User, error)
 	FindByID(ctx context.Context, id primitive.ObjectID) (*model.User, error)
-	Update(ctx context.Context, user *model.User) error
+	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.User, error)
+	Find(ctx context.Context, filters UserFilters) ([]model.User, int64, error)
+	UpdateProfile(ctx context.Context, user *model.User) error
+	UpdatePassword(ctx context.Context, id primitive.ObjectID, hash string) error
+	UpdateRole(ctx context.Context, id primitive.ObjectID, role model.Role) error
+	UpdateMFA(ctx context.Context, id primitive.ObjectID, mfa model.MFA) error
+	MarkEmailVerified(ctx context.Context, id primitive.ObjectID, verifiedAt time.Time) error
+	IncrementTokenVersion(ctx context.Context, id primitive.ObjectID) (int, error)
+	UpdatePasswordHash(ctx context.Context, id primitive.ObjectID, currentHash, newHash string) (bool, error)
+	UpdateMFALastUsedStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error)
+	ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error)
 }
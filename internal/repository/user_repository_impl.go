@@ -3,12 +3,14 @@ package repository
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type userRepositoryImpl struct {
@@ -39,7 +41,7 @@ func (r *userRepositoryImpl) Create(ctx context.Context, user *model.User) error
 
 func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user model.User
-	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&user)
+	err := r.collection.FindOne(ctx, bson.M{"email": model.NormalizeEmail(email)}).Decode(&user)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -63,13 +65,114 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id primitive.ObjectID
 	return &user, nil
 }
 
-func (r *userRepositoryImpl) Update(ctx context.Context, user *model.User) error {
+func (r *userRepositoryImpl) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []model.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+
+	if users == nil {
+		users = []model.User{}
+	}
+
+	return users, nil
+}
+
+func (r *userRepositoryImpl) Find(ctx context.Context, filters UserFilters) ([]model.User, int64, error) {
+	query := bson.M{}
+
+	if filters.Role != "" {
+		if filters.Role == string(model.RoleMember) {
+			// accounts created before roles existed have no role and count as members
+			query["role"] = bson.M{"$in": bson.A{filters.Role, "", nil}}
+		} else {
+			query["role"] = filters.Role
+		}
+	}
+
+	if filters.Search != "" {
+		query["email"] = bson.M{"$regex": regexp.QuoteMeta(filters.Search), "$options": "i"}
+	}
+
+	total, err := r.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page := filters.Page
+	if page < 1 {
+		page = 1
+	}
+
+	limit := filters.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	skip := (page - 1) * limit
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, query, findOptions)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []model.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, 0, err
+	}
+
+	if users == nil {
+		users = []model.User{}
+	}
+
+	return users, total, nil
+}
+
+// The update methods below each set only the fields their operation changes,
+// so concurrent changes to other fields, like a bumped token version or MFA
+// state, are never written back with stale values.
+
+func (r *userRepositoryImpl) UpdateProfile(ctx context.Context, user *model.User) error {
 	user.UpdatedAt = time.Now()
 
-	filter := bson.M{"_id": user.ID}
-	update := bson.M{"$set": user}
+	return r.set(ctx, user.ID, bson.M{
+		"display_name": user.DisplayName,
+		"timezone":     user.Timezone,
+		"locale":       user.Locale,
+		"updated_at":   user.UpdatedAt,
+	})
+}
 
-	result, err := r.collection.UpdateOne(ctx, filter, update)
+func (r *userRepositoryImpl) UpdatePassword(ctx context.Context, id primitive.ObjectID, hash string) error {
+	return r.set(ctx, id, bson.M{"password": hash, "updated_at": time.Now()})
+}
+
+func (r *userRepositoryImpl) UpdateRole(ctx context.Context, id primitive.ObjectID, role model.Role) error {
+	return r.set(ctx, id, bson.M{"role": role, "updated_at": time.Now()})
+}
+
+func (r *userRepositoryImpl) UpdateMFA(ctx context.Context, id primitive.ObjectID, mfa model.MFA) error {
+	return r.set(ctx, id, bson.M{"mfa": mfa, "updated_at": time.Now()})
+}
+
+func (r *userRepositoryImpl) MarkEmailVerified(ctx context.Context, id primitive.ObjectID, verifiedAt time.Time) error {
+	return r.set(ctx, id, bson.M{"email_verified_at": verifiedAt, "updated_at": time.Now()})
+}
+
+func (r *userRepositoryImpl) set(ctx context.Context, id primitive.ObjectID, fields bson.M) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": fields})
 	if err != nil {
 		return err
 	}
@@ -80,3 +183,68 @@ func (r *userRepositoryImpl) Update(ctx context.Context, user *model.User) error
 
 	return nil
 }
+
+// IncrementTokenVersion bumps the user's token version and returns the new value.
+func (r *userRepositoryImpl) IncrementTokenVersion(ctx context.Context, id primitive.ObjectID) (int, error) {
+	update := bson.M{
+		"$inc": bson.M{"token_version": 1},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+
+	var user model.User
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, errors.New("user not found")
+		}
+		return 0, err
+	}
+
+	return user.TokenVersion, nil
+}
+
+// UpdatePasswordHash replaces the stored hash of an unchanged password. It
+// reports false when the password was changed since currentHash was read.
+func (r *userRepositoryImpl) UpdatePasswordHash(ctx context.Context, id primitive.ObjectID, currentHash, newHash string) (bool, error) {
+	filter := bson.M{"_id": id, "password": currentHash}
+	update := bson.M{"$set": bson.M{"password": newHash}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// UpdateMFALastUsedStep records the TOTP step of an accepted code. It reports
+// false when that step, or a later one, was already used, so a code cannot be
+// replayed within its validity window.
+func (r *userRepositoryImpl) UpdateMFALastUsedStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error) {
+	filter := bson.M{"_id": id, "mfa.last_used_step": bson.M{"$not": bson.M{"$gte": step}}}
+	update := bson.M{"$set": bson.M{"mfa.last_used_step": step}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// ConsumeRecoveryCode removes a recovery code from the user. It reports false
+// when the code does not exist, which also covers a concurrent redemption.
+func (r *userRepositoryImpl) ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	filter := bson.M{"_id": id, "mfa.recovery_codes": codeHash}
+	update := bson.M{
+		"$pull": bson.M{"mfa.recovery_codes": codeHash},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
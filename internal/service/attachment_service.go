@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/pkg/blobstore"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const maxAttachmentNameLength = 255
+
+// AttachmentService stores files uploaded to tasks. Reading an attachment needs
+// read access to its task, uploading and deleting need write access. The
+// content type is detected from the file content, the one the client sends is
+// ignored.
+type AttachmentService interface {
+	Upload(ctx context.Context, userID, taskID, name string, size int64, body io.Reader) (*model.Attachment, error)
+	Open(ctx context.Context, userID, taskID, attachmentID string) (*model.Attachment, io.ReadSeekCloser, error)
+	Delete(ctx context.Context, userID, taskID, attachmentID string) error
+}
+
+type attachmentServiceImpl struct {
+	taskRepo     repository.TaskRepository
+	projectRepo  repository.ProjectRepository
+	blobStore    blobstore.BlobStore
+	auditService AuditService
+	config       *config.Config
+}
+
+func NewAttachmentService(taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository, blobStore blobstore.BlobStore, auditService AuditService, config *config.Config) AttachmentService {
+	return &attachmentServiceImpl{
+		taskRepo:     taskRepo,
+		projectRepo:  projectRepo,
+		blobStore:    blobStore,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+func (s *attachmentServiceImpl) Upload(ctx context.Context, userID, taskID, name string, size int64, body io.Reader) (*model.Attachment, error) {
+	attachment, err := s.upload(ctx, userID, taskID, name, size, body)
+
+	entry := model.NewAuditLog(model.AuditActionAttachmentUpload, userID, "task", taskID).WithError(err).WithMetadata("size", strconv.FormatInt(size, 10))
+	if attachment != nil {
+		entry.WithMetadata("attachment_id", attachment.ID.Hex()).WithMetadata("content_type", attachment.ContentType)
+	}
+	s.auditService.Record(ctx, entry)
+
+	return attachment, err
+}
+
+// upload streams the file into the blob store while hashing it. When the task
+// cannot be updated afterwards the stored file is removed again.
+func (s *attachmentServiceImpl) upload(ctx context.Context, userID, taskID, name string, size int64, body io.Reader) (*model.Attachment, error) {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, taskID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > s.config.Attachment.MaxSize {
+		return nil, errors.New("file too large")
+	}
+
+	if len(task.Attachments) >= s.config.Attachment.MaxPerTask {
+		return nil, errors.New("too many attachments")
+	}
+
+	reader := bufio.NewReaderSize(body, 512)
+	head, err := reader.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	if !s.isAllowedType(contentType) {
+		return nil, errors.New("file type not allowed")
+	}
+
+	uploaderID, _ := primitive.ObjectIDFromHex(userID)
+	attachment := model.NewAttachment(task.ID, uploaderID, sanitizeAttachmentName(name), contentType)
+	attachment.Size = size
+
+	hash := sha256.New()
+	if err := s.blobStore.Put(ctx, attachment.StorageKey, io.TeeReader(reader, hash), size, contentType); err != nil {
+		return nil, err
+	}
+	attachment.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.taskRepo.AddAttachment(ctx, task.ID, attachment, s.config.Attachment.MaxPerTask); err != nil {
+		deleteBlobs(ctx, s.blobStore, []string{attachment.StorageKey})
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (s *attachmentServiceImpl) isAllowedType(contentType string) bool {
+	for _, allowed := range s.config.Attachment.AllowedTypes {
+		if strings.EqualFold(allowed, contentType) {
+			return true
+		}
+	}
+	return false
+}
+
+// findAttachment returns the task's attachment when the user may access the
+// task.
+func (s *attachmentServiceImpl) findAttachment(ctx context.Context, userID, taskID, attachmentID string, write bool) (*model.Task, *model.Attachment, error) {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, taskID, write)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(attachmentID)
+	if err != nil {
+		return nil, nil, errors.New("invalid attachment ID")
+	}
+
+	attachment := task.FindAttachment(objectID)
+	if attachment == nil {
+		return nil, nil, errors.New("attachment not found")
+	}
+
+	return task, attachment, nil
+}
+
+// Open returns the attachment with a reader for its content, which the caller
+// must close.
+func (s *attachmentServiceImpl) Open(ctx context.Context, userID, taskID, attachmentID string) (*model.Attachment, io.ReadSeekCloser, error) {
+	_, attachment, err := s.findAttachment(ctx, userID, taskID, attachmentID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blob, err := s.blobStore.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, nil, errors.New("attachment not found")
+		}
+		return nil, nil, err
+	}
+
+	return attachment, blob, nil
+}
+
+func (s *attachmentServiceImpl) Delete(ctx context.Context, userID, taskID, attachmentID string) error {
+	err := s.delete(ctx, userID, taskID, attachmentID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionAttachmentDelete, userID, "task", taskID).WithError(err).WithMetadata("attachment_id", attachmentID))
+	return err
+}
+
+func (s *attachmentServiceImpl) delete(ctx context.Context, userID, taskID, attachmentID string) error {
+	task, attachment, err := s.findAttachment(ctx, userID, taskID, attachmentID, true)
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.RemoveAttachment(ctx, task.ID, attachment.ID); err != nil {
+		return err
+	}
+
+	deleteBlobs(ctx, s.blobStore, []string{attachment.StorageKey})
+	return nil
+}
+
+// sanitizeAttachmentName keeps the base name of the uploaded file without
+// control characters and cut to a sane length.
+func sanitizeAttachmentName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	for len(name) > maxAttachmentNameLength {
+		_, width := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-width]
+	}
+
+	return name
+}
+
+// deleteBlobs removes attachment files once no document refers to them
+// anymore. Failures only leave unreachable files behind, so they are logged
+// instead of failing the request.
+func deleteBlobs(ctx context.Context, blobStore blobstore.BlobStore, keys []string) {
+	for _, key := range keys {
+		if err := blobStore.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete blob %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var testPNG = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+func TestAttachmentService_Upload_Success(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	checksum := sha256.Sum256(testPNG)
+	var stored []byte
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "tasks/"+task.ID.Hex()+"/")
+		}), mock.Anything, int64(len(testPNG)), "image/png").
+		Run(func(ctx context.Context, key string, body io.Reader, size int64, contentType string) {
+			stored, _ = io.ReadAll(body)
+		}).
+		Return(nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		AddAttachment(mock.Anything, task.ID, mock.AnythingOfType("*model.Attachment"), 2).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionAttachmentUpload && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	attachment, err := attachmentService.Upload(context.Background(), userID.Hex(), task.ID.Hex(), `C:\Users\me\screenshot.png`, int64(len(testPNG)), bytes.NewReader(testPNG))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "screenshot.png", attachment.Name)
+	assert.Equal(t, "image/png", attachment.ContentType)
+	assert.Equal(t, hex.EncodeToString(checksum[:]), attachment.Checksum)
+	assert.Equal(t, userID, attachment.UploadedBy)
+	assert.Equal(t, testPNG, stored)
+}
+
+func TestAttachmentService_Upload_TypeNotAllowed(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	body := []byte("<html><script>alert(1)</script></html>")
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionAttachmentUpload && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	attachment, err := attachmentService.Upload(context.Background(), userID.Hex(), task.ID.Hex(), "image.png", int64(len(body)), bytes.NewReader(body))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, attachment)
+	assert.Equal(t, "file type not allowed", err.Error())
+}
+
+func TestAttachmentService_Upload_TooLarge(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionAttachmentUpload && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	attachment, err := attachmentService.Upload(context.Background(), userID.Hex(), task.ID.Hex(), "large.png", 4096, bytes.NewReader(testPNG))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, attachment)
+	assert.Equal(t, "file too large", err.Error())
+}
+
+func TestAttachmentService_Upload_RemovesBlobWhenTaskUpdateFails(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	var storageKey string
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Put(mock.Anything, mock.AnythingOfType("string"), mock.Anything, int64(len(testPNG)), "image/png").
+		Run(func(ctx context.Context, key string, body io.Reader, size int64, contentType string) {
+			storageKey = key
+			_, _ = io.ReadAll(body)
+		}).
+		Return(nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		AddAttachment(mock.Anything, task.ID, mock.AnythingOfType("*model.Attachment"), 2).
+		Return(errors.New("task not found")).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Delete(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return key == storageKey
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionAttachmentUpload && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	attachment, err := attachmentService.Upload(context.Background(), userID.Hex(), task.ID.Hex(), "image.png", int64(len(testPNG)), bytes.NewReader(testPNG))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, attachment)
+	assert.Equal(t, "task not found", err.Error())
+}
+
+func TestAttachmentService_Open_NoAccess(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	otherID := primitive.NewObjectID()
+	taskID := primitive.NewObjectID()
+	attachment := model.NewAttachment(taskID, ownerID, "report.pdf", "application/pdf")
+	task := &model.Task{ID: taskID, UserID: ownerID, Title: "Test Task", Attachments: []model.Attachment{*attachment}}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(task, nil).
+		Once()
+
+	// Execute
+	result, blob, err := attachmentService.Open(context.Background(), otherID.Hex(), taskID.Hex(), attachment.ID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, blob)
+	assert.Equal(t, "task not found", err.Error())
+}
+
+func TestAttachmentService_Delete_Success(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Attachment: config.AttachmentConfig{
+			MaxSize:      1024,
+			AllowedTypes: []string{"image/png", "application/pdf"},
+			MaxPerTask:   2,
+		},
+	}
+
+	attachmentService := NewAttachmentService(mockTaskRepo, mockProjectRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	taskID := primitive.NewObjectID()
+	attachment := model.NewAttachment(taskID, userID, "report.pdf", "application/pdf")
+	task := &model.Task{ID: taskID, UserID: userID, Title: "Test Task", Attachments: []model.Attachment{*attachment}}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(task, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RemoveAttachment(mock.Anything, taskID, attachment.ID).
+		Return(nil).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Delete(mock.Anything, attachment.StorageKey).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionAttachmentDelete && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := attachmentService.Delete(context.Background(), userID.Hex(), taskID.Hex(), attachment.ID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+}
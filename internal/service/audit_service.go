@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditService interface {
+	Record(ctx context.Context, entry *model.AuditLog)
+	List(ctx context.Context, params dto.AuditLogQueryParams) ([]model.AuditLog, dto.PaginationMeta, error)
+}
+
+type auditServiceImpl struct {
+	auditLogRepo repository.AuditLogRepository
+	config       *config.Config
+}
+
+func NewAuditService(auditLogRepo repository.AuditLogRepository, config *config.Config) AuditService {
+	return &auditServiceImpl{
+		auditLogRepo: auditLogRepo,
+		config:       config,
+	}
+}
+
+// Record stores entry, filling in the client and impersonator from the request
+// context when the caller didn't. Auditing must never fail the audited operation, so write
+// errors are only logged.
+func (s *auditServiceImpl) Record(ctx context.Context, entry *model.AuditLog) {
+	meta := util.RequestMetaFromContext(ctx)
+	if entry.IP == "" {
+		entry.IP = meta.IP
+	}
+	if entry.UserAgent == "" {
+		entry.UserAgent = meta.UserAgent
+	}
+	entry.UserAgent = truncateUserAgent(entry.UserAgent)
+	if entry.ImpersonatorID.IsZero() && meta.ImpersonatorID != "" {
+		entry.ImpersonatorID, _ = primitive.ObjectIDFromHex(meta.ImpersonatorID)
+	}
+
+	entry.CreatedAt = time.Now()
+	if s.config.Audit.Retention > 0 {
+		expiresAt := entry.CreatedAt.Add(s.config.Audit.Retention)
+		entry.ExpiresAt = &expiresAt
+	}
+
+	// the event happened even if the client has gone away in the meantime
+	if err := s.auditLogRepo.Create(context.WithoutCancel(ctx), entry); err != nil {
+		log.Printf("failed to write audit log entry %s: %v", entry.Action, err)
+	}
+}
+
+func (s *auditServiceImpl) List(ctx context.Context, params dto.AuditLogQueryParams) ([]model.AuditLog, dto.PaginationMeta, error) {
+	var actorID primitive.ObjectID
+	if params.ActorID != "" {
+		var err error
+		actorID, err = primitive.ObjectIDFromHex(params.ActorID)
+		if err != nil {
+			return nil, dto.PaginationMeta{}, errors.New("invalid actor ID")
+		}
+	}
+
+	var impersonatorID primitive.ObjectID
+	if params.ImpersonatorID != "" {
+		var err error
+		impersonatorID, err = primitive.ObjectIDFromHex(params.ImpersonatorID)
+		if err != nil {
+			return nil, dto.PaginationMeta{}, errors.New("invalid impersonator ID")
+		}
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 20
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+
+	filters := repository.AuditLogFilters{
+		ActorID:        actorID,
+		ImpersonatorID: impersonatorID,
+		Action:         params.Action,
+		TargetType:     params.TargetType,
+		TargetID:       params.TargetID,
+		Outcome:        params.Outcome,
+		From:           params.From,
+		To:             params.To,
+		Page:           params.Page,
+		Limit:          params.Limit,
+	}
+
+	entries, total, err := s.auditLogRepo.Find(ctx, filters)
+	if err != nil {
+		return nil, dto.PaginationMeta{}, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(params.Limit)))
+
+	meta := dto.PaginationMeta{
+		Total:      total,
+		Page:       params.Page,
+		Limit:      params.Limit,
+		TotalPages: totalPages,
+	}
+
+	return entries, meta, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAuditService_Record_FillsRequestMetaAndRetention(t *testing.T) {
+	// Setup
+	mockAuditLogRepo := mocks.NewMockAuditLogRepository(t)
+	cfg := &config.Config{
+		Audit: config.AuditConfig{Retention: 90 * 24 * time.Hour},
+	}
+
+	auditService := NewAuditService(mockAuditLogRepo, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	ctx := util.WithRequestMeta(context.Background(), util.RequestMeta{IP: "203.0.113.10", UserAgent: "test-agent"})
+	entry := model.NewAuditLog(model.AuditActionTaskDelete, userID.Hex(), "task", "task-id")
+
+	// Mock expectations
+	mockAuditLogRepo.EXPECT().
+		Create(mock.Anything, entry).
+		Return(nil).
+		Once()
+
+	// Execute
+	auditService.Record(ctx, entry)
+
+	// Assert
+	assert.Equal(t, userID, entry.ActorID)
+	assert.Equal(t, "203.0.113.10", entry.IP)
+	assert.Equal(t, "test-agent", entry.UserAgent)
+	assert.Equal(t, model.AuditOutcomeSuccess, entry.Outcome)
+	assert.NotNil(t, entry.ExpiresAt)
+	assert.WithinDuration(t, entry.CreatedAt.Add(cfg.Audit.Retention), *entry.ExpiresAt, time.Second)
+}
+
+func TestAuditService_Record_KeepsForeverWithoutRetention(t *testing.T) {
+	// Setup
+	mockAuditLogRepo := mocks.NewMockAuditLogRepository(t)
+	cfg := &config.Config{}
+
+	auditService := NewAuditService(mockAuditLogRepo, cfg)
+
+	// Test data
+	entry := model.NewAuditLog(model.AuditActionLoginFailed, "", "user", "")
+	entry.IP = "198.51.100.7"
+
+	// Mock expectations
+	mockAuditLogRepo.EXPECT().
+		Create(mock.Anything, entry).
+		Return(assert.AnError).
+		Once()
+
+	// Execute
+	auditService.Record(context.Background(), entry)
+
+	// Assert
+	assert.True(t, entry.ActorID.IsZero())
+	assert.Equal(t, "198.51.100.7", entry.IP)
+	assert.Nil(t, entry.ExpiresAt)
+}
+
+func TestAuditService_List_Success(t *testing.T) {
+	// Setup
+	mockAuditLogRepo := mocks.NewMockAuditLogRepository(t)
+	cfg := &config.Config{}
+
+	auditService := NewAuditService(mockAuditLogRepo, cfg)
+
+	// Test data
+	actorID := primitive.NewObjectID()
+	params := dto.AuditLogQueryParams{
+		ActorID: actorID.Hex(),
+		Action:  string(model.AuditActionLoginFailed),
+		From:    "2026-01-01",
+		Limit:   500,
+	}
+	entries := []model.AuditLog{
+		*model.NewAuditLog(model.AuditActionLoginFailed, actorID.Hex(), "user", actorID.Hex()),
+	}
+
+	// Mock expectations
+	mockAuditLogRepo.EXPECT().
+		Find(mock.Anything, repository.AuditLogFilters{
+			ActorID: actorID,
+			Action:  string(model.AuditActionLoginFailed),
+			From:    "2026-01-01",
+			Page:    1,
+			Limit:   100,
+		}).
+		Return(entries, int64(1), nil).
+		Once()
+
+	// Execute
+	result, meta, err := auditService.List(context.Background(), params)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entries, result)
+	assert.Equal(t, int64(1), meta.Total)
+	assert.Equal(t, 1, meta.Page)
+	assert.Equal(t, 100, meta.Limit)
+	assert.Equal(t, 1, meta.TotalPages)
+}
+
+func TestAuditService_List_InvalidActorID(t *testing.T) {
+	// Setup
+	mockAuditLogRepo := mocks.NewMockAuditLogRepository(t)
+	cfg := &config.Config{}
+
+	auditService := NewAuditService(mockAuditLogRepo, cfg)
+
+	// Execute
+	result, _, err := auditService.List(context.Background(), dto.AuditLogQueryParams{ActorID: "not-an-id"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "invalid actor ID", err.Error())
+	assert.Nil(t, result)
+}
+
+func TestAuditService_Record_FillsImpersonator(t *testing.T) {
+	// Setup
+	mockAuditLogRepo := mocks.NewMockAuditLogRepository(t)
+	cfg := &config.Config{}
+
+	auditService := NewAuditService(mockAuditLogRepo, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	adminID := primitive.NewObjectID()
+	ctx := util.WithRequestMeta(context.Background(), util.RequestMeta{IP: "203.0.113.10", ImpersonatorID: adminID.Hex()})
+	entry := model.NewAuditLog(model.AuditActionTaskUpdate, userID.Hex(), "task", "task-id")
+
+	// Mock expectations
+	mockAuditLogRepo.EXPECT().
+		Create(mock.Anything, entry).
+		Return(nil).
+		Once()
+
+	// Execute
+	auditService.Record(ctx, entry)
+
+	// Assert
+	assert.Equal(t, userID, entry.ActorID)
+	assert.Equal(t, adminID, entry.ImpersonatorID)
+}
@@ -3,55 +3,434 @@ package service
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"github.com/grachmannico95/mileapp-test-be/internal/repository"
 	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type AuthService interface {
-	Login(ctx context.Context, email, password string) (*model.User, string, string, error)
-	Register(ctx context.Context, email, password string) (*model.User, error)
+	Login(ctx context.Context, email, password, ip, userAgent string) (*model.User, *dto.AuthTokens, error)
+	CompleteMFALogin(ctx context.Context, mfaToken, code, ip, userAgent string) (*model.User, *dto.AuthTokens, error)
+	CompleteExternalLogin(ctx context.Context, user *model.User, ip, userAgent string) (*model.User, *dto.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken, ip, userAgent string) (*model.User, *dto.AuthTokens, error)
+	Register(ctx context.Context, email, password, inviteCode string) (*model.User, error)
+	CreateInvite(ctx context.Context, userID, email string) (*model.Invite, error)
+	Logout(ctx context.Context, claims *util.JWTClaims, refreshToken string) error
+	LogoutAll(ctx context.Context, userID string) error
+	IssueCSRFToken(claims *util.JWTClaims) string
 }
 
 type authServiceImpl struct {
-	userRepo repository.UserRepository
-	config   *config.Config
+	userRepo                 repository.UserRepository
+	inviteRepo               repository.InviteRepository
+	refreshTokenRepo         repository.RefreshTokenRepository
+	loginAttemptRepo         repository.LoginAttemptRepository
+	sessionRepo              repository.SessionRepository
+	tokenService             TokenService
+	emailVerificationService EmailVerificationService
+	mfaService               MFAService
+	auditService             AuditService
+	config                   *config.Config
+	dummyHashOnce            sync.Once
+	dummyHash                string
 }
 
-func NewAuthService(userRepo repository.UserRepository, config *config.Config) AuthService {
+func NewAuthService(userRepo repository.UserRepository, inviteRepo repository.InviteRepository, refreshTokenRepo repository.RefreshTokenRepository, loginAttemptRepo repository.LoginAttemptRepository, sessionRepo repository.SessionRepository, tokenService TokenService, emailVerificationService EmailVerificationService, mfaService MFAService, auditService AuditService, config *config.Config) AuthService {
 	return &authServiceImpl{
-		userRepo: userRepo,
-		config:   config,
+		userRepo:                 userRepo,
+		inviteRepo:               inviteRepo,
+		refreshTokenRepo:         refreshTokenRepo,
+		loginAttemptRepo:         loginAttemptRepo,
+		sessionRepo:              sessionRepo,
+		tokenService:             tokenService,
+		emailVerificationService: emailVerificationService,
+		mfaService:               mfaService,
+		auditService:             auditService,
+		config:                   config,
 	}
 }
 
-func (s *authServiceImpl) Login(ctx context.Context, email, password string) (*model.User, string, string, error) {
+func (s *authServiceImpl) Login(ctx context.Context, email, password, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	user, tokens, err := s.login(ctx, email, password, ip, userAgent)
+
+	actorID := ""
+	if user != nil {
+		actorID = user.ID.Hex()
+	}
+	s.recordLogin(ctx, actorID, email, "password", ip, userAgent, err)
+
+	return user, tokens, err
+}
+
+func (s *authServiceImpl) login(ctx context.Context, email, password, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	attemptKeys := []string{emailAttemptKey(email)}
+	if ip != "" {
+		attemptKeys = append(attemptKeys, ipAttemptKey(ip))
+	}
+
+	if err := s.checkLoginAllowed(ctx, attemptKeys...); err != nil {
+		return nil, nil, err
+	}
+
 	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
-		return nil, "", "", err
+		return nil, nil, err
+	}
+
+	// unknown accounts and accounts without a password still pay for a hash
+	// verification, so the response time does not reveal which emails exist
+	if user == nil || user.Password == "" {
+		util.VerifyPassword(password, s.dummyPasswordHash(), &s.config.Password)
+		if err := s.recordLoginFailure(ctx, email, ip); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.New("invalid email or password")
+	}
+
+	match, needsRehash := util.VerifyPassword(password, user.Password, &s.config.Password)
+	if !match {
+		if err := s.recordLoginFailure(ctx, email, ip); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.New("invalid email or password")
+	}
+
+	if needsRehash {
+		s.rehashPassword(ctx, user, password)
+	}
+
+	if s.config.EmailVerification.Required && !user.IsEmailVerified() {
+		return nil, nil, errors.New("email address is not verified")
+	}
+
+	// failed attempts are only cleared once the second factor is passed too,
+	// otherwise knowing the password would allow unlimited code guesses
+	if user.MFA.Enabled {
+		return nil, nil, &MFARequiredError{
+			Token:     s.generateMFAToken(user),
+			ExpiresIn: s.config.MFA.PendingTokenExpiry,
+		}
+	}
+
+	if err := s.loginAttemptRepo.Reset(ctx, emailAttemptKey(email)); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.startSession(ctx, user, ip, userAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// dummyPasswordHash is hashed with the configured algorithm on first use, so
+// verifying against it costs as much as verifying a real password.
+func (s *authServiceImpl) dummyPasswordHash() string {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = util.HashPassword(uuid.NewString(), &s.config.Password)
+	})
+	return s.dummyHash
+}
+
+// CompleteMFALogin finishes a login that was answered with MFARequiredError by
+// exchanging the pending token and a TOTP or recovery code for real tokens.
+func (s *authServiceImpl) CompleteMFALogin(ctx context.Context, mfaToken, code, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	user, tokens, err := s.completeMFALogin(ctx, mfaToken, code, ip, userAgent)
+
+	actorID := ""
+	if userID, ok := s.parseMFAToken(mfaToken); ok {
+		actorID = userID.Hex()
+	}
+	s.recordLogin(ctx, actorID, "", "mfa", ip, userAgent, err)
+
+	return user, tokens, err
+}
+
+func (s *authServiceImpl) completeMFALogin(ctx context.Context, mfaToken, code, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	userID, ok := s.parseMFAToken(mfaToken)
+	if !ok {
+		return nil, nil, errors.New("invalid or expired mfa token")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user == nil || !user.MFA.Enabled {
+		return nil, nil, errors.New("invalid or expired mfa token")
+	}
+
+	attemptKeys := []string{emailAttemptKey(user.Email)}
+	if ip != "" {
+		attemptKeys = append(attemptKeys, ipAttemptKey(ip))
+	}
+
+	if err := s.checkLoginAllowed(ctx, attemptKeys...); err != nil {
+		return nil, nil, err
+	}
+
+	valid, err := s.mfaService.Verify(ctx, user, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !valid {
+		if err := s.recordLoginFailure(ctx, user.Email, ip); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.New("invalid mfa code")
+	}
+
+	if err := s.loginAttemptRepo.Reset(ctx, emailAttemptKey(user.Email)); err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.startSession(ctx, user, ip, userAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// CompleteExternalLogin issues tokens for a user that has been authenticated by
+// someone else, e.g. an OpenID Connect provider. MFA is still enforced.
+func (s *authServiceImpl) CompleteExternalLogin(ctx context.Context, user *model.User, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	if user.MFA.Enabled {
+		return nil, nil, &MFARequiredError{
+			Token:     s.generateMFAToken(user),
+			ExpiresIn: s.config.MFA.PendingTokenExpiry,
+		}
+	}
+
+	tokens, err := s.startSession(ctx, user, ip, userAgent)
+	s.recordLogin(ctx, user.ID.Hex(), "", "external", ip, userAgent, err)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// recordLogin audits the outcome of a login attempt. A login waiting for its
+// second factor is not final yet and is recorded once that step completes.
+func (s *authServiceImpl) recordLogin(ctx context.Context, actorID, email, method, ip, userAgent string, err error) {
+	var mfaErr *MFARequiredError
+	if errors.As(err, &mfaErr) {
+		return
+	}
+
+	action := model.AuditActionLogin
+	if err != nil {
+		action = model.AuditActionLoginFailed
+	}
+
+	entry := model.NewAuditLog(action, actorID, "user", actorID).WithError(err).WithMetadata("method", method)
+	if email != "" {
+		entry.WithMetadata("email", email)
+	}
+	entry.IP = ip
+	entry.UserAgent = userAgent
+
+	s.auditService.Record(ctx, entry)
+}
+
+// Refresh rotates a refresh token: the presented token is consumed and a new
+// one from the same family is issued. Presenting an already consumed token is
+// treated as theft and revokes every token in its family.
+func (s *authServiceImpl) Refresh(ctx context.Context, refreshToken, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	if refreshToken == "" {
+		return nil, nil, errors.New("invalid refresh token")
+	}
+
+	stored, err := s.refreshTokenRepo.FindByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stored == nil || stored.RevokedAt != nil || time.Now().After(stored.ExpiresAt) {
+		return nil, nil, errors.New("invalid refresh token")
+	}
+
+	if stored.UsedAt != nil {
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.New("refresh token reuse detected")
+	}
+
+	marked, err := s.refreshTokenRepo.MarkUsed(ctx, stored.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !marked {
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, errors.New("refresh token reuse detected")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if user == nil {
-		return nil, "", "", errors.New("invalid email or password")
+		return nil, nil, errors.New("invalid refresh token")
+	}
+
+	session, err := s.resumeSession(ctx, user, stored.FamilyID, ip, userAgent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.issueTokens(ctx, user, session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// Logout revokes the presented access token and, when given, the refresh token
+// family it was issued with.
+func (s *authServiceImpl) Logout(ctx context.Context, claims *util.JWTClaims, refreshToken string) error {
+	err := s.logout(ctx, claims, refreshToken)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionLogout, claims.UserID, "session", claims.SessionID).WithError(err))
+	return err
+}
+
+func (s *authServiceImpl) logout(ctx context.Context, claims *util.JWTClaims, refreshToken string) error {
+	if err := s.tokenService.Revoke(ctx, claims); err != nil {
+		return err
+	}
+
+	if claims.SessionID != "" {
+		if err := s.tokenService.RevokeSession(ctx, claims.UserID, claims.SessionID); err != nil && err.Error() != "session not found" {
+			return err
+		}
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	stored, err := s.refreshTokenRepo.FindByHash(ctx, util.HashToken(refreshToken))
+	if err != nil {
+		return err
+	}
+
+	if stored == nil || stored.UserID.Hex() != claims.UserID {
+		return nil
+	}
+
+	return s.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID)
+}
+
+// LogoutAll invalidates every access and refresh token the user holds.
+func (s *authServiceImpl) LogoutAll(ctx context.Context, userID string) error {
+	err := s.tokenService.RevokeAllForUser(ctx, userID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionLogoutAll, userID, "user", userID).WithError(err))
+	return err
+}
+
+// rehashPassword upgrades a hash made with an outdated algorithm or cost while
+// the plaintext is at hand. It is best effort: the old hash keeps working.
+func (s *authServiceImpl) rehashPassword(ctx context.Context, user *model.User, password string) {
+	hashedPassword, err := util.HashPassword(password, &s.config.Password)
+	if err != nil {
+		return
+	}
+
+	updated, err := s.userRepo.UpdatePasswordHash(ctx, user.ID, user.Password, hashedPassword)
+	if err == nil && updated {
+		user.Password = hashedPassword
+	}
+}
+
+// startSession records a new session for a successful login and issues the
+// first tokens for it.
+func (s *authServiceImpl) startSession(ctx context.Context, user *model.User, ip, userAgent string) (*dto.AuthTokens, error) {
+	session := model.NewSession(user.ID, uuid.New().String(), truncateUserAgent(userAgent), ip, s.config.JWT.RefreshExpiry)
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, session)
+}
+
+// resumeSession looks up the session a refresh token family belongs to and
+// records the activity. Families issued before sessions existed get a session
+// on their first refresh.
+func (s *authServiceImpl) resumeSession(ctx context.Context, user *model.User, familyID, ip, userAgent string) (*model.Session, error) {
+	session, err := s.sessionRepo.FindByFamily(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session == nil {
+		session = model.NewSession(user.ID, familyID, truncateUserAgent(userAgent), ip, s.config.JWT.RefreshExpiry)
+		if err := s.sessionRepo.Create(ctx, session); err != nil {
+			return nil, err
+		}
+		return session, nil
+	}
+
+	if session.RevokedAt != nil || session.UserID != user.ID {
+		if err := s.refreshTokenRepo.RevokeFamily(ctx, familyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("invalid refresh token")
 	}
 
-	if !util.CheckPasswordHash(password, user.Password) {
-		return nil, "", "", errors.New("invalid email or password")
+	now := time.Now()
+	if err := s.sessionRepo.Touch(ctx, session.ID, ip, now, now.Add(s.config.JWT.RefreshExpiry)); err != nil {
+		return nil, err
 	}
 
-	jwtToken, err := util.GenerateJWT(user.ID, user.Email, s.config.JWT.Secret, s.config.JWT.Expiry)
+	return session, nil
+}
+
+func (s *authServiceImpl) issueTokens(ctx context.Context, user *model.User, session *model.Session) (*dto.AuthTokens, error) {
+	jwtToken, err := util.GenerateJWT(user, session.ID.Hex(), &s.config.JWT)
 	if err != nil {
-		return nil, "", "", err
+		return nil, err
+	}
+
+	refreshToken, err := util.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
 	}
 
-	csrfToken := util.GenerateCSRFToken(s.config.CSRF.Secret)
+	stored := model.NewRefreshToken(user.ID, session.FamilyID, util.HashToken(refreshToken), s.config.JWT.RefreshExpiry)
+	if err := s.refreshTokenRepo.Create(ctx, stored); err != nil {
+		return nil, err
+	}
 
-	return user, jwtToken, csrfToken, nil
+	return &dto.AuthTokens{
+		AccessToken:  jwtToken,
+		CSRFToken:    s.IssueCSRFToken(&util.JWTClaims{UserID: user.ID.Hex(), SessionID: session.ID.Hex()}),
+		RefreshToken: refreshToken,
+	}, nil
 }
 
-func (s *authServiceImpl) Register(ctx context.Context, email, password string) (*model.User, error) {
+func (s *authServiceImpl) Register(ctx context.Context, email, password, inviteCode string) (*model.User, error) {
+	email = model.NormalizeEmail(email)
+
+	if err := util.ValidatePassword(password, email, &s.config.Password); err != nil {
+		return nil, err
+	}
+
 	existingUser, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		return nil, err
@@ -61,16 +440,78 @@ func (s *authServiceImpl) Register(ctx context.Context, email, password string)
 		return nil, errors.New("email already exists")
 	}
 
-	hashedPassword, err := util.HashPassword(password)
+	hashedPassword, err := util.HashPassword(password, &s.config.Password)
 	if err != nil {
 		return nil, err
 	}
 
+	var invite *model.Invite
+	if s.config.Registration.InviteOnly {
+		if inviteCode == "" {
+			return nil, errors.New("invite code is required")
+		}
+
+		invite, err = s.inviteRepo.Claim(ctx, inviteCode, email)
+		if err != nil {
+			return nil, err
+		}
+
+		if invite == nil {
+			return nil, errors.New("invalid or expired invite code")
+		}
+	}
+
 	user := model.NewUser(email, hashedPassword)
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
+		// give the invite back so the user can retry with the same code
+		if invite != nil {
+			_ = s.inviteRepo.Release(ctx, invite.ID)
+		}
 		return nil, err
 	}
 
+	// the account already exists at this point, so a mail failure must not fail
+	// the registration; the user can ask for another link
+	_ = s.emailVerificationService.SendVerification(ctx, user)
+
 	return user, nil
 }
+
+// CreateInvite checks the creator's current role itself, so invites cannot be
+// issued by accounts that lost the permission or through a route missing
+// RequirePermission.
+func (s *authServiceImpl) CreateInvite(ctx context.Context, userID, email string) (*model.Invite, error) {
+	creatorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	creator, err := s.userRepo.FindByID(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if creator == nil || !creator.GetRole().HasPermission(model.PermissionInvitesWrite) {
+		return nil, errors.New("insufficient permissions")
+	}
+
+	code, err := util.GenerateRandomToken(24)
+	if err != nil {
+		return nil, err
+	}
+
+	invite := model.NewInvite(code, email, creatorID, s.config.Registration.InviteExpiry)
+
+	if err := s.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// IssueCSRFToken returns a CSRF token that CSRFMiddleware only accepts together
+// with an access token for the same session.
+func (s *authServiceImpl) IssueCSRFToken(claims *util.JWTClaims) string {
+	return util.GenerateCSRFToken(util.CSRFSubject(claims), s.config.CSRF.Expiry, s.config.CSRF.Secret)
+}
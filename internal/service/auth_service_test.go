@@ -2,14 +2,22 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"github.com/grachmannico95/mileapp-test-be/internal/util"
 	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/jwks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/password"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -18,6 +26,14 @@ import (
 func TestAuthService_Login_Success(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -25,16 +41,18 @@ func TestAuthService_Login_Success(t *testing.T) {
 		},
 		CSRF: config.CSRFConfig{
 			Secret: "csrf-secret",
+			Expiry: time.Hour,
 		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "test@example.com"
 	password := "password123"
-	hashedPassword, _ := util.HashPassword(password)
+	hashedPassword, _ := util.HashPassword(password, &cfg.Password)
 	userID := primitive.NewObjectID()
+	ip := "203.0.113.10"
 
 	user := &model.User{
 		ID:        userID,
@@ -45,31 +63,247 @@ func TestAuthService_Login_Success(t *testing.T) {
 	}
 
 	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "ip:"+ip).
+		Return(nil, nil).
+		Once()
+
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, email).
 		Return(user, nil).
 		Once()
 
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(session *model.Session) bool {
+			return session.UserID == userID && session.FamilyID != "" && session.UserAgent == "test-agent"
+		})).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.RefreshToken) bool {
+			return token.UserID == userID && token.FamilyID != "" && token.TokenHash != ""
+		})).
+		Return(nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		Reset(mock.Anything, "email:"+email).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogin && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
 	// Execute
-	resultUser, jwtToken, csrfToken, err := authService.Login(context.Background(), email, password)
+	resultUser, tokens, err := authService.Login(context.Background(), email, password, ip, "test-agent")
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, resultUser)
 	assert.Equal(t, email, resultUser.Email)
-	assert.NotEmpty(t, jwtToken)
-	assert.NotEmpty(t, csrfToken)
+	assert.NotNil(t, tokens)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.CSRFToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
 
 	// Verify JWT token is valid
-	claims, err := util.ValidateJWT(jwtToken, cfg.JWT.Secret)
+	claims, err := util.ValidateJWT(tokens.AccessToken, &cfg.JWT)
 	assert.NoError(t, err)
 	assert.Equal(t, userID.Hex(), claims.UserID)
 	assert.Equal(t, email, claims.Email)
+
+	// Verify CSRF token is bound to the new session
+	assert.True(t, util.ValidateCSRFToken(tokens.CSRFToken, util.CSRFSubject(claims), cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(tokens.CSRFToken, "session:"+primitive.NewObjectID().Hex(), cfg.CSRF.Secret))
+}
+
+func TestAuthService_Login_SignsWithRSAKey(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keySet, err := jwks.NewKeySet(privateKey)
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Expiry:   15 * time.Minute,
+			Issuer:   "https://auth.example.com",
+			Audience: "mileapp-api",
+			KeySet:   keySet,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	password := "password123"
+	hashedPassword, _ := util.HashPassword(password, &cfg.Password)
+	userID := primitive.NewObjectID()
+	ip := "203.0.113.10"
+
+	user := &model.User{
+		ID:       userID,
+		Email:    email,
+		Password: hashedPassword,
+	}
+
+	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Twice()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(user, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(session *model.Session) bool {
+			return session.UserID == userID && session.FamilyID != "" && session.UserAgent == "test-agent"
+		})).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		Reset(mock.Anything, "email:"+email).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogin && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	_, tokens, err := authService.Login(context.Background(), email, password, ip, "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+
+	claims, err := util.ValidateJWT(tokens.AccessToken, &cfg.JWT)
+	assert.NoError(t, err)
+	assert.Equal(t, userID.Hex(), claims.UserID)
+	assert.Equal(t, "https://auth.example.com", claims.Issuer)
+
+	jwkSet := keySet.JWKS()
+	assert.Len(t, jwkSet.Keys, 1)
+	assert.Equal(t, "RSA", jwkSet.Keys[0].KeyType)
+	assert.Equal(t, "RS256", jwkSet.Keys[0].Algorithm)
+
+	// a token meant for another audience or issuer is rejected
+	otherAudience := cfg.JWT
+	otherAudience.Audience = "another-service"
+	_, err = util.ValidateJWT(tokens.AccessToken, &otherAudience)
+	assert.Error(t, err)
+
+	otherIssuer := cfg.JWT
+	otherIssuer.Issuer = "https://evil.example.com"
+	_, err = util.ValidateJWT(tokens.AccessToken, &otherIssuer)
+	assert.Error(t, err)
+
+	// an HS256 deployment sharing nothing with the key set cannot verify it
+	_, err = util.ValidateJWT(tokens.AccessToken, &config.JWTConfig{Secret: "test-secret"})
+	assert.Error(t, err)
+}
+
+func TestAuthService_Login_AcceptsRotatedEdDSAKeys(t *testing.T) {
+	// Setup
+	oldPublicKey, oldPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	_, newPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	oldKeySet, err := jwks.NewKeySet(oldPrivateKey)
+	assert.NoError(t, err)
+	rotatedKeySet, err := jwks.NewKeySet(newPrivateKey, oldPublicKey)
+	assert.NoError(t, err)
+	unrelatedKeySet, err := jwks.NewKeySet(newPrivateKey)
+	assert.NoError(t, err)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+
+	oldConfig := &config.JWTConfig{Expiry: 15 * time.Minute, KeySet: oldKeySet}
+	rotatedConfig := &config.JWTConfig{Expiry: 15 * time.Minute, KeySet: rotatedKeySet}
+	unrelatedConfig := &config.JWTConfig{Expiry: 15 * time.Minute, KeySet: unrelatedKeySet}
+
+	// Execute
+	oldToken, err := util.GenerateJWT(user, "", oldConfig)
+	assert.NoError(t, err)
+	newToken, err := util.GenerateJWT(user, "", rotatedConfig)
+	assert.NoError(t, err)
+
+	// Assert
+	_, err = util.ValidateJWT(oldToken, rotatedConfig)
+	assert.NoError(t, err)
+	_, err = util.ValidateJWT(newToken, rotatedConfig)
+	assert.NoError(t, err)
+
+	// once the old key is dropped its tokens are no longer accepted
+	_, err = util.ValidateJWT(oldToken, unrelatedConfig)
+	assert.Error(t, err)
+
+	jwkSet := rotatedKeySet.JWKS()
+	assert.Len(t, jwkSet.Keys, 2)
+	for _, key := range jwkSet.Keys {
+		assert.Equal(t, "OKP", key.KeyType)
+		assert.Equal(t, "EdDSA", key.Algorithm)
+		assert.NotEmpty(t, key.KeyID)
+	}
 }
 
 func TestAuthService_Login_UserNotFound(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -78,34 +312,76 @@ func TestAuthService_Login_UserNotFound(t *testing.T) {
 		CSRF: config.CSRFConfig{
 			Secret: "csrf-secret",
 		},
+		Password: config.PasswordConfig{
+			Argon2Memory:      8 * 1024,
+			Argon2Iterations:  1,
+			Argon2Parallelism: 1,
+		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "nonexistent@example.com"
 	password := "password123"
+	ip := "203.0.113.10"
 
 	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "ip:"+ip).
+		Return(nil, nil).
+		Once()
+
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, email).
 		Return(nil, nil).
 		Once()
 
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "email:"+email, mock.Anything).
+		Return(&model.LoginAttempt{Failures: 1}, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "ip:"+ip, mock.Anything).
+		Return(&model.LoginAttempt{Failures: 1}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
 	// Execute
-	resultUser, jwtToken, csrfToken, err := authService.Login(context.Background(), email, password)
+	resultUser, tokens, err := authService.Login(context.Background(), email, password, ip, "test-agent")
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resultUser)
-	assert.Empty(t, jwtToken)
-	assert.Empty(t, csrfToken)
+	assert.Nil(t, tokens)
 	assert.Equal(t, "invalid email or password", err.Error())
+	// the unknown account was checked against a real hash to keep the timing equal
+	assert.True(t, strings.HasPrefix(authService.(*authServiceImpl).dummyHash, "$argon2id$"))
 }
 
 func TestAuthService_Login_InvalidPassword(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -116,13 +392,14 @@ func TestAuthService_Login_InvalidPassword(t *testing.T) {
 		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "test@example.com"
 	correctPassword := "password123"
 	wrongPassword := "wrongpassword"
-	hashedPassword, _ := util.HashPassword(correctPassword)
+	hashedPassword, _ := util.HashPassword(correctPassword, &cfg.Password)
+	ip := "203.0.113.10"
 
 	user := &model.User{
 		ID:        primitive.NewObjectID(),
@@ -133,25 +410,59 @@ func TestAuthService_Login_InvalidPassword(t *testing.T) {
 	}
 
 	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "ip:"+ip).
+		Return(nil, nil).
+		Once()
+
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, email).
 		Return(user, nil).
 		Once()
 
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "email:"+email, mock.Anything).
+		Return(&model.LoginAttempt{Failures: 1}, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "ip:"+ip, mock.Anything).
+		Return(&model.LoginAttempt{Failures: 1}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
 	// Execute
-	resultUser, jwtToken, csrfToken, err := authService.Login(context.Background(), email, wrongPassword)
+	resultUser, tokens, err := authService.Login(context.Background(), email, wrongPassword, ip, "test-agent")
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resultUser)
-	assert.Empty(t, jwtToken)
-	assert.Empty(t, csrfToken)
+	assert.Nil(t, tokens)
 	assert.Equal(t, "invalid email or password", err.Error())
 }
 
 func TestAuthService_Login_RepositoryError(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -162,32 +473,57 @@ func TestAuthService_Login_RepositoryError(t *testing.T) {
 		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "test@example.com"
 	password := "password123"
+	ip := "203.0.113.10"
 
 	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "ip:"+ip).
+		Return(nil, nil).
+		Once()
+
 	mockUserRepo.EXPECT().
 		FindByEmail(mock.Anything, email).
 		Return(nil, errors.New("database error")).
 		Once()
 
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
 	// Execute
-	resultUser, jwtToken, csrfToken, err := authService.Login(context.Background(), email, password)
+	resultUser, tokens, err := authService.Login(context.Background(), email, password, ip, "test-agent")
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resultUser)
-	assert.Empty(t, jwtToken)
-	assert.Empty(t, csrfToken)
+	assert.Nil(t, tokens)
 	assert.Equal(t, "database error", err.Error())
 }
 
 func TestAuthService_Register_Success(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -198,7 +534,7 @@ func TestAuthService_Register_Success(t *testing.T) {
 		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "newuser@example.com"
@@ -217,19 +553,84 @@ func TestAuthService_Register_Success(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockEmailVerificationService.EXPECT().
+		SendVerification(mock.Anything, mock.AnythingOfType("*model.User")).
+		Return(nil).
+		Once()
+
 	// Execute
-	resultUser, err := authService.Register(context.Background(), email, password)
+	resultUser, err := authService.Register(context.Background(), email, password, "")
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, resultUser)
 	assert.Equal(t, email, resultUser.Email)
 	assert.NotEqual(t, password, resultUser.Password) // Password should be hashed
+	assert.Equal(t, model.RoleMember, resultUser.Role)
+}
+
+func TestAuthService_Register_NormalizesEmail(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "  Jane.Doe@Example.com "
+	password := "password123"
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "jane.doe@example.com").
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(user *model.User) bool {
+			return user.Email == "jane.doe@example.com" && user.Role == model.RoleMember
+		})).
+		Return(nil).
+		Once()
+
+	mockEmailVerificationService.EXPECT().
+		SendVerification(mock.Anything, mock.AnythingOfType("*model.User")).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), email, password, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "jane.doe@example.com", resultUser.Email)
+	assert.Equal(t, model.RoleMember, resultUser.Role)
 }
 
 func TestAuthService_Register_EmailExists(t *testing.T) {
 	// Setup
 	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
 	cfg := &config.Config{
 		JWT: config.JWTConfig{
 			Secret: "test-secret",
@@ -240,7 +641,7 @@ func TestAuthService_Register_EmailExists(t *testing.T) {
 		},
 	}
 
-	authService := NewAuthService(mockUserRepo, cfg)
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
 
 	// Test data
 	email := "existing@example.com"
@@ -258,10 +659,1618 @@ func TestAuthService_Register_EmailExists(t *testing.T) {
 		Once()
 
 	// Execute
-	resultUser, err := authService.Register(context.Background(), email, password)
+	resultUser, err := authService.Register(context.Background(), email, password, "")
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resultUser)
 	assert.Equal(t, "email already exists", err.Error())
 }
+
+func TestAuthService_Register_BreachedPassword(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	// SHA-1 of "Password123", in the Pwned Passwords "HASH:COUNT" format
+	breachedList, err := password.ReadBreachedList(strings.NewReader("# sample\nB2E98AD6F6EB8508DD6A14CFA704BAD7F05F6FB1:112\n"))
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Password: config.PasswordConfig{
+			MinLength:    8,
+			MaxLength:    128,
+			RequireUpper: true,
+			RequireLower: true,
+			RequireDigit: true,
+			BreachedList: breachedList,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), "new@example.com", "Password123", "")
+
+	// Assert
+	assert.Nil(t, resultUser)
+	var policyErr *password.PolicyError
+	assert.ErrorAs(t, err, &policyErr)
+	assert.Len(t, policyErr.Violations, 1)
+	assert.Equal(t, "breached", policyErr.Violations[0].Rule)
+}
+
+func TestAuthService_Register_WeakPassword(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Password: config.PasswordConfig{
+			MinLength:     12,
+			MaxLength:     128,
+			RequireUpper:  true,
+			RequireSymbol: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), "new@example.com", "password1", "")
+
+	// Assert
+	assert.Nil(t, resultUser)
+	assert.EqualError(t, err, "password does not meet the policy requirements")
+	assert.Equal(t, []dto.ErrorItem{
+		{Field: "password", Code: "min_length", Message: "password must be at least 12 characters"},
+		{Field: "password", Code: "uppercase", Message: "password must contain at least one uppercase letter"},
+		{Field: "password", Code: "symbol", Message: "password must contain at least one symbol"},
+	}, util.ParseValidationError(err))
+}
+
+func TestAuthService_Register_InviteOnly_MissingCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Registration: config.RegistrationConfig{
+			InviteOnly: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "newuser@example.com"
+	password := "Password123"
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), email, password, "")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Equal(t, "invite code is required", err.Error())
+}
+
+func TestAuthService_Register_InviteOnly_InvalidCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Registration: config.RegistrationConfig{
+			InviteOnly: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "newuser@example.com"
+	password := "Password123"
+	code := "used-or-unknown-code"
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(nil, nil).
+		Once()
+
+	mockInviteRepo.EXPECT().
+		Claim(mock.Anything, code, email).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), email, password, code)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Equal(t, "invalid or expired invite code", err.Error())
+}
+
+func TestAuthService_Register_InviteOnly_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Registration: config.RegistrationConfig{
+			InviteOnly: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "newuser@example.com"
+	password := "Password123"
+	code := "valid-code"
+	invite := &model.Invite{
+		ID:   primitive.NewObjectID(),
+		Code: code,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(nil, nil).
+		Once()
+
+	mockInviteRepo.EXPECT().
+		Claim(mock.Anything, code, email).
+		Return(invite, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockEmailVerificationService.EXPECT().
+		SendVerification(mock.Anything, mock.AnythingOfType("*model.User")).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), email, password, code)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, resultUser)
+	assert.Equal(t, email, resultUser.Email)
+}
+
+func TestAuthService_Register_InviteOnly_ReleasesInviteOnFailure(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Registration: config.RegistrationConfig{
+			InviteOnly: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "newuser@example.com"
+	password := "Password123"
+	code := "valid-code"
+	invite := &model.Invite{
+		ID:   primitive.NewObjectID(),
+		Code: code,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(nil, nil).
+		Once()
+
+	mockInviteRepo.EXPECT().
+		Claim(mock.Anything, code, email).
+		Return(invite, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(errors.New("email already exists")).
+		Once()
+
+	mockInviteRepo.EXPECT().
+		Release(mock.Anything, invite.ID).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, err := authService.Register(context.Background(), email, password, code)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Equal(t, "email already exists", err.Error())
+}
+
+func TestAuthService_Refresh_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret:        "test-secret",
+			Expiry:        15 * time.Minute,
+			RefreshExpiry: 24 * time.Hour,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	refreshToken := "current-refresh-token"
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+	stored := model.NewRefreshToken(user.ID, "family-1", util.HashToken(refreshToken), time.Hour)
+	stored.ID = primitive.NewObjectID()
+	session := model.NewSession(user.ID, "family-1", "test-agent", "198.51.100.7", 24*time.Hour)
+	session.ID = primitive.NewObjectID()
+
+	// Mock expectations
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		MarkUsed(mock.Anything, stored.ID).
+		Return(true, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		FindByFamily(mock.Anything, "family-1").
+		Return(session, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Touch(mock.Anything, session.ID, "203.0.113.10", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+		Return(nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.RefreshToken) bool {
+			return token.FamilyID == "family-1" && token.TokenHash != stored.TokenHash
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Refresh(context.Background(), refreshToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user, resultUser)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+	assert.NotEqual(t, refreshToken, tokens.RefreshToken)
+
+	claims, err := util.ValidateJWT(tokens.AccessToken, &cfg.JWT)
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID.Hex(), claims.SessionID)
+}
+
+func TestAuthService_Refresh_CreatesSessionForLegacyFamily(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret:        "test-secret",
+			Expiry:        15 * time.Minute,
+			RefreshExpiry: 24 * time.Hour,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	refreshToken := "current-refresh-token"
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+	stored := model.NewRefreshToken(user.ID, "legacy-family", util.HashToken(refreshToken), time.Hour)
+	stored.ID = primitive.NewObjectID()
+
+	// Mock expectations
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		MarkUsed(mock.Anything, stored.ID).
+		Return(true, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		FindByFamily(mock.Anything, "legacy-family").
+		Return(nil, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(session *model.Session) bool {
+			return session.UserID == user.ID && session.FamilyID == "legacy-family" && session.IP == "203.0.113.10"
+		})).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.RefreshToken) bool {
+			return token.FamilyID == "legacy-family"
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	_, tokens, err := authService.Refresh(context.Background(), refreshToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+
+	claims, err := util.ValidateJWT(tokens.AccessToken, &cfg.JWT)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, claims.SessionID)
+}
+
+func TestAuthService_Refresh_RevokedSession(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret:        "test-secret",
+			Expiry:        15 * time.Minute,
+			RefreshExpiry: 24 * time.Hour,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	refreshToken := "current-refresh-token"
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+	stored := model.NewRefreshToken(user.ID, "family-1", util.HashToken(refreshToken), time.Hour)
+	stored.ID = primitive.NewObjectID()
+	revokedAt := time.Now()
+	session := model.NewSession(user.ID, "family-1", "test-agent", "198.51.100.7", 24*time.Hour)
+	session.ID = primitive.NewObjectID()
+	session.RevokedAt = &revokedAt
+
+	// Mock expectations
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		MarkUsed(mock.Anything, stored.ID).
+		Return(true, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		FindByFamily(mock.Anything, "family-1").
+		Return(session, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(mock.Anything, "family-1").
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Refresh(context.Background(), refreshToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.EqualError(t, err, "invalid refresh token")
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+}
+
+func TestAuthService_Refresh_ReuseRevokesFamily(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	refreshToken := "already-rotated-token"
+	usedAt := time.Now().Add(-time.Minute)
+	stored := model.NewRefreshToken(primitive.NewObjectID(), "family-1", util.HashToken(refreshToken), time.Hour)
+	stored.ID = primitive.NewObjectID()
+	stored.UsedAt = &usedAt
+
+	// Mock expectations
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(mock.Anything, "family-1").
+		Return(nil).
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Refresh(context.Background(), refreshToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	assert.Equal(t, "refresh token reuse detected", err.Error())
+}
+
+func TestAuthService_Refresh_Expired(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	refreshToken := "expired-token"
+	stored := model.NewRefreshToken(primitive.NewObjectID(), "family-1", util.HashToken(refreshToken), -time.Minute)
+
+	// Mock expectations
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Refresh(context.Background(), refreshToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	assert.Equal(t, "invalid refresh token", err.Error())
+}
+
+func TestAuthService_Logout_RevokesAccessAndRefreshTokens(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	claims := &util.JWTClaims{UserID: userID.Hex()}
+	refreshToken := "refresh-token"
+	stored := model.NewRefreshToken(userID, "family-1", util.HashToken(refreshToken), time.Hour)
+
+	// Mock expectations
+	mockTokenService.EXPECT().
+		Revoke(mock.Anything, claims).
+		Return(nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(mock.Anything, "family-1").
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogout && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := authService.Logout(context.Background(), claims, refreshToken)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAuthService_Logout_IgnoresForeignRefreshToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	claims := &util.JWTClaims{UserID: primitive.NewObjectID().Hex()}
+	refreshToken := "someone-elses-token"
+	stored := model.NewRefreshToken(primitive.NewObjectID(), "family-2", util.HashToken(refreshToken), time.Hour)
+
+	// Mock expectations
+	mockTokenService.EXPECT().
+		Revoke(mock.Anything, claims).
+		Return(nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(refreshToken)).
+		Return(stored, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogout && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := authService.Logout(context.Background(), claims, refreshToken)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAuthService_LogoutAll(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID().Hex()
+
+	// Mock expectations
+	mockTokenService.EXPECT().
+		RevokeAllForUser(mock.Anything, userID).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogoutAll && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := authService.LogoutAll(context.Background(), userID)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestAuthService_Login_LockedAccount(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	ip := "203.0.113.10"
+	lockedUntil := time.Now().Add(10 * time.Minute)
+
+	// Mock expectations: the password is never checked while locked
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(&model.LoginAttempt{LockedUntil: &lockedUntil, ExpiresAt: lockedUntil}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Login(context.Background(), email, "password123", ip, "test-agent")
+
+	// Assert
+	var throttled *LoginThrottledError
+	assert.ErrorAs(t, err, &throttled)
+	assert.Equal(t, LoginErrorCodeLocked, throttled.Code)
+	assert.InDelta(t, 10*time.Minute, throttled.RetryAfter, float64(time.Second))
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+}
+
+func TestAuthService_Login_BackoffAfterRepeatedFailures(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		LoginProtection: config.LoginProtectionConfig{
+			BackoffAfter:    3,
+			BackoffBase:     time.Second,
+			LockoutDuration: 15 * time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	ip := "203.0.113.10"
+	attempt := &model.LoginAttempt{
+		Failures:      5,
+		LastFailureAt: time.Now(),
+		ExpiresAt:     time.Now().Add(15 * time.Minute),
+	}
+
+	// Mock expectations: 5 failures with back-off after 3 means a 4s delay
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(attempt, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	_, _, err := authService.Login(context.Background(), email, "password123", ip, "test-agent")
+
+	// Assert
+	var throttled *LoginThrottledError
+	assert.ErrorAs(t, err, &throttled)
+	assert.Equal(t, LoginErrorCodeThrottled, throttled.Code)
+	assert.InDelta(t, 4*time.Second, throttled.RetryAfter, float64(100*time.Millisecond))
+}
+
+func TestAuthService_Login_LocksAfterThreshold(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		LoginProtection: config.LoginProtectionConfig{
+			MaxAttempts:     5,
+			IPMaxAttempts:   20,
+			LockoutDuration: 15 * time.Minute,
+			AttemptWindow:   15 * time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "nonexistent@example.com"
+	ip := "203.0.113.10"
+
+	// Mock expectations: unknown emails are counted and locked like real ones
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Twice()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(nil, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "email:"+email, cfg.LoginProtection.AttemptWindow).
+		Return(&model.LoginAttempt{Failures: 5}, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "ip:"+ip, cfg.LoginProtection.AttemptWindow).
+		Return(&model.LoginAttempt{Failures: 5}, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		Lock(mock.Anything, "email:"+email, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	_, _, err := authService.Login(context.Background(), email, "password123", ip, "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "invalid email or password", err.Error())
+}
+
+func TestAuthService_Login_UnverifiedEmail(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		EmailVerification: config.EmailVerificationConfig{
+			Required: true,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	password := "password123"
+	hashedPassword, _ := util.HashPassword(password, &cfg.Password)
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    email,
+		Password: hashedPassword,
+	}
+
+	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(user, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Login(context.Background(), email, password, "", "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	assert.Equal(t, "email address is not verified", err.Error())
+}
+
+func TestAuthService_Login_MFARequired(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		MFA: config.MFAConfig{
+			PendingTokenExpiry: 5 * time.Minute,
+			Secret:             "mfa-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	password := "password123"
+	hashedPassword, _ := util.HashPassword(password, &cfg.Password)
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    email,
+		Password: hashedPassword,
+		MFA:      model.MFA{Enabled: true, Secret: "SECRET"},
+	}
+
+	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.Login(context.Background(), email, password, "", "test-agent")
+
+	// Assert
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	var mfaRequired *MFARequiredError
+	assert.ErrorAs(t, err, &mfaRequired)
+	assert.NotEmpty(t, mfaRequired.Token)
+	assert.Equal(t, 5*time.Minute, mfaRequired.ExpiresIn)
+}
+
+func TestAuthService_CompleteMFALogin_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		MFA: config.MFAConfig{
+			PendingTokenExpiry: 5 * time.Minute,
+			Secret:             "mfa-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+		MFA:   model.MFA{Enabled: true, Secret: "SECRET"},
+	}
+	ip := "203.0.113.10"
+	mfaToken := authService.(*authServiceImpl).generateMFAToken(user)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, mock.AnythingOfType("string")).
+		Return(nil, nil).
+		Times(2)
+
+	mockMFAService.EXPECT().
+		Verify(mock.Anything, user, "123456").
+		Return(true, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		Reset(mock.Anything, "email:"+user.Email).
+		Return(nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(session *model.Session) bool {
+			return session.UserID == user.ID && session.FamilyID != "" && session.UserAgent == "test-agent"
+		})).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*model.RefreshToken")).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogin && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.CompleteMFALogin(context.Background(), mfaToken, "123456", ip, "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, resultUser.ID)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+}
+
+func TestAuthService_CompleteMFALogin_InvalidCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			PendingTokenExpiry: 5 * time.Minute,
+			Secret:             "mfa-secret",
+		},
+		LoginProtection: config.LoginProtectionConfig{
+			MaxAttempts:   5,
+			IPMaxAttempts: 20,
+			BackoffAfter:  3,
+			BackoffBase:   time.Second,
+			AttemptWindow: 15 * time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+		MFA:   model.MFA{Enabled: true, Secret: "SECRET"},
+	}
+	mfaToken := authService.(*authServiceImpl).generateMFAToken(user)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+user.Email).
+		Return(nil, nil).
+		Once()
+
+	mockMFAService.EXPECT().
+		Verify(mock.Anything, user, "000000").
+		Return(false, nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		RecordFailure(mock.Anything, "email:"+user.Email, 15*time.Minute).
+		Return(&model.LoginAttempt{Key: "email:" + user.Email, Failures: 1}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.CompleteMFALogin(context.Background(), mfaToken, "000000", "", "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	assert.Equal(t, "invalid mfa code", err.Error())
+}
+
+func TestAuthService_CompleteMFALogin_TamperedToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Secret: "mfa-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	payload := "mfa:" + strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10) + ":" + primitive.NewObjectID().Hex()
+	mfaToken := util.SignToken(payload, "another-secret")
+
+	// Mock expectations
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLoginFailed && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.CompleteMFALogin(context.Background(), mfaToken, "123456", "", "test-agent")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+	assert.Equal(t, "invalid or expired mfa token", err.Error())
+}
+
+func TestAuthService_CompleteExternalLogin_RequiresMFA(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			PendingTokenExpiry: 5 * time.Minute,
+			Secret:             "mfa-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+		MFA:   model.MFA{Enabled: true, Secret: "SECRET"},
+	}
+
+	// Execute
+	resultUser, tokens, err := authService.CompleteExternalLogin(context.Background(), user, "203.0.113.10", "test-agent")
+
+	// Assert
+	var mfaRequired *MFARequiredError
+	assert.ErrorAs(t, err, &mfaRequired)
+	assert.NotEmpty(t, mfaRequired.Token)
+	assert.Nil(t, resultUser)
+	assert.Nil(t, tokens)
+}
+
+func TestAuthService_CompleteExternalLogin_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+
+	// Mock expectations
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(session *model.Session) bool {
+			return session.UserID == user.ID && session.FamilyID != "" && session.UserAgent == "test-agent"
+		})).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.RefreshToken) bool {
+			return token.UserID == user.ID
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogin && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, tokens, err := authService.CompleteExternalLogin(context.Background(), user, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user, resultUser)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.CSRFToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+}
+
+func TestAuthService_IssueCSRFToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID().Hex()
+	claims := &util.JWTClaims{UserID: userID, SessionID: primitive.NewObjectID().Hex()}
+	otherSession := &util.JWTClaims{UserID: userID, SessionID: primitive.NewObjectID().Hex()}
+	otherUser := &util.JWTClaims{UserID: primitive.NewObjectID().Hex()}
+
+	// Execute
+	token := authService.IssueCSRFToken(claims)
+
+	// Assert
+	assert.True(t, util.ValidateCSRFToken(token, util.CSRFSubject(claims), cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(token, util.CSRFSubject(otherSession), cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(token, util.CSRFSubject(otherUser), cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(token, "", cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(token, util.CSRFSubject(claims), "other-secret"))
+}
+
+func TestAuthService_IssueCSRFToken_Expired(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: -time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	claims := &util.JWTClaims{UserID: primitive.NewObjectID().Hex(), SessionID: primitive.NewObjectID().Hex()}
+
+	// Execute
+	token := authService.IssueCSRFToken(claims)
+
+	// Assert
+	assert.False(t, util.ValidateCSRFToken(token, util.CSRFSubject(claims), cfg.CSRF.Secret))
+}
+
+func TestAuthService_Login_RehashesOutdatedPassword(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		Password: config.PasswordConfig{
+			Algorithm:         "argon2id",
+			Argon2Memory:      8 * 1024,
+			Argon2Iterations:  1,
+			Argon2Parallelism: 1,
+			BcryptCost:        10,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	password := "password123"
+	legacyHash, _ := util.HashPassword(password, &config.PasswordConfig{Algorithm: "bcrypt", BcryptCost: 10})
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    email,
+		Password: legacyHash,
+	}
+
+	// Mock expectations
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdatePasswordHash(mock.Anything, user.ID, legacyHash, mock.MatchedBy(func(hash string) bool {
+			return strings.HasPrefix(hash, "$argon2id$v=19$m=8192,t=1,p=1$")
+		})).
+		Return(true, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, session *model.Session) error {
+			session.ID = primitive.NewObjectID()
+			return nil
+		}).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockLoginAttemptRepo.EXPECT().
+		Reset(mock.Anything, "email:"+email).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLogin && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	resultUser, _, err := authService.Login(context.Background(), email, password, "", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	match, needsRehash := util.VerifyPassword(password, resultUser.Password, &cfg.Password)
+	assert.True(t, match)
+	assert.False(t, needsRehash)
+}
+
+func TestAuthService_Login_RehashesOutdatedArgon2Parameters(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Password: config.PasswordConfig{
+			Algorithm:         "argon2id",
+			Argon2Memory:      16 * 1024,
+			Argon2Iterations:  2,
+			Argon2Parallelism: 1,
+		},
+		MFA: config.MFAConfig{
+			Secret:             "mfa-secret",
+			PendingTokenExpiry: 5 * time.Minute,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	email := "test@example.com"
+	password := "password123"
+	outdatedHash, _ := util.HashPassword(password, &config.PasswordConfig{Argon2Memory: 8 * 1024, Argon2Iterations: 1, Argon2Parallelism: 1})
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    email,
+		Password: outdatedHash,
+		MFA:      model.MFA{Enabled: true},
+	}
+
+	// Mock expectations: the hash is upgraded even though the second factor is still pending
+	mockLoginAttemptRepo.EXPECT().
+		FindByKey(mock.Anything, "email:"+email).
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, email).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdatePasswordHash(mock.Anything, user.ID, outdatedHash, mock.MatchedBy(func(hash string) bool {
+			return strings.HasPrefix(hash, "$argon2id$v=19$m=16384,t=2,p=1$")
+		})).
+		Return(true, nil).
+		Once()
+
+	// Execute
+	_, _, err := authService.Login(context.Background(), email, password, "", "test-agent")
+
+	// Assert
+	var mfaErr *MFARequiredError
+	assert.ErrorAs(t, err, &mfaErr)
+}
+
+func TestAuthService_CreateInvite_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Registration: config.RegistrationConfig{
+			InviteExpiry: 24 * time.Hour,
+		},
+	}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	admin := &model.User{
+		ID:   primitive.NewObjectID(),
+		Role: model.RoleAdmin,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, admin.ID).
+		Return(admin, nil).
+		Once()
+
+	mockInviteRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(invite *model.Invite) bool {
+			return invite.Email == "guest@example.com" && invite.CreatedBy == admin.ID
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	invite, err := authService.CreateInvite(context.Background(), admin.ID.Hex(), "Guest@Example.com")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, invite.Code)
+}
+
+func TestAuthService_CreateInvite_MemberForbidden(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockInviteRepo := mocks.NewMockInviteRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockLoginAttemptRepo := mocks.NewMockLoginAttemptRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockEmailVerificationService := mocks.NewMockEmailVerificationService(t)
+	mockMFAService := mocks.NewMockMFAService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{}
+
+	authService := NewAuthService(mockUserRepo, mockInviteRepo, mockRefreshTokenRepo, mockLoginAttemptRepo, mockSessionRepo, mockTokenService, mockEmailVerificationService, mockMFAService, mockAuditService, cfg)
+
+	// Test data
+	member := &model.User{
+		ID:   primitive.NewObjectID(),
+		Role: model.RoleMember,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, member.ID).
+		Return(member, nil).
+		Once()
+
+	// Execute
+	invite, err := authService.CreateInvite(context.Background(), member.ID.Hex(), "guest@example.com")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, invite)
+	assert.Equal(t, "insufficient permissions", err.Error())
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CommentService lets everyone who can see a task discuss it. List returns a
+// page of top-level comments together with all of their replies.
+type CommentService interface {
+	List(ctx context.Context, userID, taskID string, params dto.CommentQueryParams) ([]model.Comment, []model.Comment, dto.PaginationMeta, error)
+	Create(ctx context.Context, userID, taskID string, req dto.CreateCommentRequest) (*model.Comment, error)
+	Update(ctx context.Context, userID, taskID, commentID string, req dto.UpdateCommentRequest) (*model.Comment, error)
+	Delete(ctx context.Context, userID, taskID, commentID string) error
+}
+
+type commentServiceImpl struct {
+	commentRepo  repository.CommentRepository
+	taskRepo     repository.TaskRepository
+	projectRepo  repository.ProjectRepository
+	auditService AuditService
+}
+
+func NewCommentService(commentRepo repository.CommentRepository, taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository, auditService AuditService) CommentService {
+	return &commentServiceImpl{
+		commentRepo:  commentRepo,
+		taskRepo:     taskRepo,
+		projectRepo:  projectRepo,
+		auditService: auditService,
+	}
+}
+
+func (s *commentServiceImpl) List(ctx context.Context, userID, taskID string, params dto.CommentQueryParams) ([]model.Comment, []model.Comment, dto.PaginationMeta, error) {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, taskID, false)
+	if err != nil {
+		return nil, nil, dto.PaginationMeta{}, err
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 20
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+
+	comments, total, err := s.commentRepo.FindTopLevel(ctx, task.ID, params.Page, params.Limit)
+	if err != nil {
+		return nil, nil, dto.PaginationMeta{}, err
+	}
+
+	replies := []model.Comment{}
+	if len(comments) > 0 {
+		parentIDs := make([]primitive.ObjectID, len(comments))
+		for i, comment := range comments {
+			parentIDs[i] = comment.ID
+		}
+
+		replies, err = s.commentRepo.FindReplies(ctx, parentIDs)
+		if err != nil {
+			return nil, nil, dto.PaginationMeta{}, err
+		}
+	}
+
+	meta := dto.PaginationMeta{
+		Total:      total,
+		Page:       params.Page,
+		Limit:      params.Limit,
+		TotalPages: int(math.Ceil(float64(total) / float64(params.Limit))),
+	}
+
+	return comments, replies, meta, nil
+}
+
+func (s *commentServiceImpl) Create(ctx context.Context, userID, taskID string, req dto.CreateCommentRequest) (*model.Comment, error) {
+	comment, err := s.create(ctx, userID, taskID, req)
+
+	targetID := ""
+	if comment != nil {
+		targetID = comment.ID.Hex()
+	}
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionCommentCreate, userID, "comment", targetID).WithError(err).WithMetadata("task_id", taskID))
+
+	return comment, err
+}
+
+func (s *commentServiceImpl) create(ctx context.Context, userID, taskID string, req dto.CreateCommentRequest) (*model.Comment, error) {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, taskID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, errors.New("comment body is required")
+	}
+
+	var parentID *primitive.ObjectID
+	if req.ParentID != "" {
+		parent, err := s.findComment(ctx, task, req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parent.IsReply() {
+			return nil, errors.New("cannot reply to a reply")
+		}
+
+		parentID = &parent.ID
+	}
+
+	authorID, _ := primitive.ObjectIDFromHex(userID)
+	comment := model.NewComment(task, parentID, authorID, body)
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// findComment returns the comment when it belongs to the task.
+func (s *commentServiceImpl) findComment(ctx context.Context, task *model.Task, id string) (*model.Comment, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	comment, err := s.commentRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment == nil || comment.TaskID != task.ID {
+		return nil, errors.New("comment not found")
+	}
+
+	return comment, nil
+}
+
+// findOwnComment is findComment for changes, which only the author may make.
+func (s *commentServiceImpl) findOwnComment(ctx context.Context, userID, taskID, commentID string) (*model.Comment, error) {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, taskID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findComment(ctx, task, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorID.Hex() != userID {
+		return nil, errors.New("cannot modify another user's comment")
+	}
+
+	return comment, nil
+}
+
+func (s *commentServiceImpl) Update(ctx context.Context, userID, taskID, commentID string, req dto.UpdateCommentRequest) (*model.Comment, error) {
+	comment, err := s.update(ctx, userID, taskID, commentID, req)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionCommentUpdate, userID, "comment", commentID).WithError(err).WithMetadata("task_id", taskID))
+	return comment, err
+}
+
+func (s *commentServiceImpl) update(ctx context.Context, userID, taskID, commentID string, req dto.UpdateCommentRequest) (*model.Comment, error) {
+	comment, err := s.findOwnComment(ctx, userID, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, errors.New("comment body is required")
+	}
+
+	if body == comment.Body {
+		return comment, nil
+	}
+
+	now := time.Now()
+	comment.Body = body
+	comment.Edited = true
+	comment.EditedAt = &now
+
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (s *commentServiceImpl) Delete(ctx context.Context, userID, taskID, commentID string) error {
+	err := s.delete(ctx, userID, taskID, commentID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionCommentDelete, userID, "comment", commentID).WithError(err).WithMetadata("task_id", taskID))
+	return err
+}
+
+// delete also removes the replies of a top-level comment.
+func (s *commentServiceImpl) delete(ctx context.Context, userID, taskID, commentID string) error {
+	comment, err := s.findOwnComment(ctx, userID, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	return s.commentRepo.Delete(ctx, comment.ID)
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCommentService_Create_Success(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(comment *model.Comment) bool {
+			return comment.TaskID == task.ID &&
+				comment.AuthorID == userID &&
+				comment.ParentID == nil &&
+				comment.Body == "Looks good"
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionCommentCreate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	comment, err := commentService.Create(context.Background(), userID.Hex(), task.ID.Hex(), dto.CreateCommentRequest{Body: "  Looks good  "})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Looks good", comment.Body)
+	assert.False(t, comment.Edited)
+}
+
+func TestCommentService_Create_ReplyToReply(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	rootID := primitive.NewObjectID()
+	reply := &model.Comment{ID: primitive.NewObjectID(), TaskID: task.ID, ParentID: &rootID, AuthorID: userID}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		FindByID(mock.Anything, reply.ID).
+		Return(reply, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionCommentCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	comment, err := commentService.Create(context.Background(), userID.Hex(), task.ID.Hex(), dto.CreateCommentRequest{
+		ParentID: reply.ID.Hex(),
+		Body:     "Nested",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, comment)
+	assert.Equal(t, "cannot reply to a reply", err.Error())
+}
+
+func TestCommentService_Create_NoTaskAccess(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	outsiderID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: primitive.NewObjectID(), Title: "Test Task"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionCommentCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	comment, err := commentService.Create(context.Background(), outsiderID.Hex(), task.ID.Hex(), dto.CreateCommentRequest{Body: "Hello"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, comment)
+	assert.Equal(t, "task not found", err.Error())
+}
+
+func TestCommentService_List_WithReplies(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	root := model.Comment{ID: primitive.NewObjectID(), TaskID: task.ID, AuthorID: userID, Body: "Question"}
+	reply := model.Comment{ID: primitive.NewObjectID(), TaskID: task.ID, ParentID: &root.ID, AuthorID: userID, Body: "Answer"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		FindTopLevel(mock.Anything, task.ID, 1, 20).
+		Return([]model.Comment{root}, int64(1), nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		FindReplies(mock.Anything, []primitive.ObjectID{root.ID}).
+		Return([]model.Comment{reply}, nil).
+		Once()
+
+	// Execute
+	comments, replies, meta, err := commentService.List(context.Background(), userID.Hex(), task.ID.Hex(), dto.CommentQueryParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Len(t, replies, 1)
+	assert.Equal(t, int64(1), meta.Total)
+	assert.Equal(t, 1, meta.TotalPages)
+}
+
+func TestCommentService_Update_MarksEdited(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	comment := &model.Comment{ID: primitive.NewObjectID(), TaskID: task.ID, AuthorID: userID, Body: "Old"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		FindByID(mock.Anything, comment.ID).
+		Return(comment, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(c *model.Comment) bool {
+			return c.Body == "New" && c.Edited && c.EditedAt != nil
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionCommentUpdate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := commentService.Update(context.Background(), userID.Hex(), task.ID.Hex(), comment.ID.Hex(), dto.UpdateCommentRequest{Body: "New"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.Edited)
+}
+
+func TestCommentService_Delete_OtherAuthor(t *testing.T) {
+	// Setup
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	commentService := NewCommentService(mockCommentRepo, mockTaskRepo, mockProjectRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	task := &model.Task{ID: primitive.NewObjectID(), UserID: userID, Title: "Test Task"}
+	comment := &model.Comment{ID: primitive.NewObjectID(), TaskID: task.ID, AuthorID: primitive.NewObjectID(), Body: "Not mine"}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, task.ID).
+		Return(task, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		FindByID(mock.Anything, comment.ID).
+		Return(comment, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionCommentDelete && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := commentService.Delete(context.Background(), userID.Hex(), task.ID.Hex(), comment.ID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "cannot modify another user's comment", err.Error())
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/pkg/mailer"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, user *model.User) error
+	Verify(ctx context.Context, token string) (*model.User, error)
+	Resend(ctx context.Context, email string) error
+}
+
+type emailVerificationServiceImpl struct {
+	userRepo repository.UserRepository
+	mailer   mailer.Mailer
+	config   *config.Config
+}
+
+func NewEmailVerificationService(userRepo repository.UserRepository, mailer mailer.Mailer, config *config.Config) EmailVerificationService {
+	return &emailVerificationServiceImpl{
+		userRepo: userRepo,
+		mailer:   mailer,
+		config:   config,
+	}
+}
+
+func (s *emailVerificationServiceImpl) SendVerification(ctx context.Context, user *model.User) error {
+	token := s.generateToken(user, time.Now().Add(s.config.EmailVerification.Expiry))
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Please confirm your email address by opening the link below. It expires in %d hours.\n\n%s\n\nIf you did not create an account, you can ignore this email.",
+			int(s.config.EmailVerification.Expiry.Hours()),
+			s.config.EmailVerification.URL+"?token="+url.QueryEscape(token),
+		),
+	})
+}
+
+// Verify marks the account named by token as verified. Verifying an already
+// verified account is not an error so that opening the link twice is harmless.
+func (s *emailVerificationServiceImpl) Verify(ctx context.Context, token string) (*model.User, error) {
+	userID, email, ok := s.parseToken(token)
+	if !ok {
+		return nil, errors.New("invalid or expired verification token")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a token issued before an email change must not verify the new address
+	if user == nil || !strings.EqualFold(user.Email, email) {
+		return nil, errors.New("invalid or expired verification token")
+	}
+
+	if user.IsEmailVerified() {
+		return user, nil
+	}
+
+	now := time.Now()
+	if err := s.userRepo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+		return nil, err
+	}
+	user.EmailVerifiedAt = &now
+
+	return user, nil
+}
+
+// Resend issues a fresh link for an unverified account. Unknown and already
+// verified addresses are silently ignored and send failures only logged, so
+// callers cannot probe for accounts.
+func (s *emailVerificationServiceImpl) Resend(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if user == nil || user.IsEmailVerified() {
+		return nil
+	}
+
+	if err := s.SendVerification(ctx, user); err != nil {
+		log.Printf("failed to send verification link to user %s: %v", user.ID.Hex(), err)
+	}
+
+	return nil
+}
+
+// the payload is "<expiry>:<user id>:<email>"; the email goes last because it
+// is the only part that may itself contain a colon
+func (s *emailVerificationServiceImpl) generateToken(user *model.User, expiresAt time.Time) string {
+	payload := strconv.FormatInt(expiresAt.Unix(), 10) + ":" + user.ID.Hex() + ":" + user.Email
+	return util.SignToken(payload, s.config.EmailVerification.Secret)
+}
+
+func (s *emailVerificationServiceImpl) parseToken(token string) (primitive.ObjectID, string, bool) {
+	payload, ok := util.VerifySignedToken(token, s.config.EmailVerification.Secret)
+	if !ok {
+		return primitive.NilObjectID, "", false
+	}
+
+	parts := strings.SplitN(payload, ":", 3)
+	if len(parts) != 3 {
+		return primitive.NilObjectID, "", false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return primitive.NilObjectID, "", false
+	}
+
+	userID, err := primitive.ObjectIDFromHex(parts[1])
+	if err != nil {
+		return primitive.NilObjectID, "", false
+	}
+
+	return userID, parts[2], true
+}
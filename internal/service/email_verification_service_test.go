@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/mailer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestEmailVerificationService_SendAndVerify(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		EmailVerification: config.EmailVerificationConfig{
+			Expiry: 24 * time.Hour,
+			URL:    "http://localhost:5173/verify-email",
+			Secret: "verification-secret",
+		},
+	}
+
+	emailVerificationService := NewEmailVerificationService(mockUserRepo, mockMailer, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+	var link string
+
+	// Mock expectations
+	mockMailer.EXPECT().
+		Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+			idx := strings.Index(msg.Body, cfg.EmailVerification.URL+"?token=")
+			if msg.To != user.Email || idx < 0 {
+				return false
+			}
+			link = strings.Fields(msg.Body[idx:])[0]
+			return true
+		})).
+		Return(nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		MarkEmailVerified(mock.Anything, user.ID, mock.AnythingOfType("time.Time")).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := emailVerificationService.SendVerification(context.Background(), user)
+	assert.NoError(t, err)
+
+	token := strings.TrimPrefix(link, cfg.EmailVerification.URL+"?token=")
+	verifiedUser, err := emailVerificationService.Verify(context.Background(), token)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, verifiedUser.IsEmailVerified())
+}
+
+func TestEmailVerificationService_Verify_ExpiredToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		EmailVerification: config.EmailVerificationConfig{
+			Expiry: 24 * time.Hour,
+			URL:    "http://localhost:5173/verify-email",
+			Secret: "verification-secret",
+		},
+	}
+
+	emailVerificationService := NewEmailVerificationService(mockUserRepo, mockMailer, cfg)
+
+	// Test data
+	expiresAt := time.Now().Add(-time.Minute).Unix()
+	payload := strconv.FormatInt(expiresAt, 10) + ":" + primitive.NewObjectID().Hex() + ":test@example.com"
+	token := util.SignToken(payload, cfg.EmailVerification.Secret)
+
+	// Execute
+	user, err := emailVerificationService.Verify(context.Background(), token)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, user)
+	assert.Equal(t, "invalid or expired verification token", err.Error())
+}
+
+func TestEmailVerificationService_Verify_TamperedToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		EmailVerification: config.EmailVerificationConfig{
+			Expiry: 24 * time.Hour,
+			URL:    "http://localhost:5173/verify-email",
+			Secret: "verification-secret",
+		},
+	}
+
+	emailVerificationService := NewEmailVerificationService(mockUserRepo, mockMailer, cfg)
+
+	// Test data
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	payload := strconv.FormatInt(expiresAt, 10) + ":" + primitive.NewObjectID().Hex() + ":test@example.com"
+	token := util.SignToken(payload, "another-secret")
+
+	// Execute
+	user, err := emailVerificationService.Verify(context.Background(), token)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, user)
+	assert.Equal(t, "invalid or expired verification token", err.Error())
+}
+
+func TestEmailVerificationService_Verify_EmailChanged(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		EmailVerification: config.EmailVerificationConfig{
+			Expiry: 24 * time.Hour,
+			URL:    "http://localhost:5173/verify-email",
+			Secret: "verification-secret",
+		},
+	}
+
+	emailVerificationService := NewEmailVerificationService(mockUserRepo, mockMailer, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "new@example.com",
+	}
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	payload := strconv.FormatInt(expiresAt, 10) + ":" + user.ID.Hex() + ":old@example.com"
+	token := util.SignToken(payload, cfg.EmailVerification.Secret)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	result, err := emailVerificationService.Verify(context.Background(), token)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "invalid or expired verification token", err.Error())
+}
+
+func TestEmailVerificationService_Resend_AlreadyVerified(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		EmailVerification: config.EmailVerificationConfig{
+			Expiry: 24 * time.Hour,
+			URL:    "http://localhost:5173/verify-email",
+			Secret: "verification-secret",
+		},
+	}
+
+	emailVerificationService := NewEmailVerificationService(mockUserRepo, mockMailer, cfg)
+
+	// Test data
+	verifiedAt := time.Now()
+	user := &model.User{
+		ID:              primitive.NewObjectID(),
+		Email:           "test@example.com",
+		EmailVerifiedAt: &verifiedAt,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, user.Email).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	err := emailVerificationService.Resend(context.Background(), user.Email)
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImpersonationService lets admins act as another user for support purposes.
+// Every start and stop is audited together with the stated reason.
+type ImpersonationService interface {
+	Start(ctx context.Context, claims *util.JWTClaims, userID, reason string) (*model.User, *dto.AuthTokens, error)
+	Stop(ctx context.Context, claims *util.JWTClaims) error
+}
+
+type impersonationServiceImpl struct {
+	userRepo     repository.UserRepository
+	tokenService TokenService
+	auditService AuditService
+	config       *config.Config
+}
+
+func NewImpersonationService(userRepo repository.UserRepository, tokenService TokenService, auditService AuditService, config *config.Config) ImpersonationService {
+	return &impersonationServiceImpl{
+		userRepo:     userRepo,
+		tokenService: tokenService,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+func (s *impersonationServiceImpl) Start(ctx context.Context, claims *util.JWTClaims, userID, reason string) (*model.User, *dto.AuthTokens, error) {
+	user, tokens, err := s.start(ctx, claims, userID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionImpersonationStart, claims.UserID, "user", userID).WithError(err).WithMetadata("reason", reason))
+	return user, tokens, err
+}
+
+// start issues a short-lived access token for the user. It carries the admin
+// in its actor claim and has no refresh token, so it simply runs out.
+func (s *impersonationServiceImpl) start(ctx context.Context, claims *util.JWTClaims, userID string) (*model.User, *dto.AuthTokens, error) {
+	if claims.IsImpersonation() {
+		return nil, nil, errors.New("already impersonating a user")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, nil, errors.New("invalid user ID")
+	}
+
+	if userID == claims.UserID {
+		return nil, nil, errors.New("cannot impersonate yourself")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user == nil {
+		return nil, nil, errors.New("user not found")
+	}
+
+	// acting as another admin would hand out admin rights under a different name
+	if user.GetRole() == model.RoleAdmin {
+		return nil, nil, errors.New("cannot impersonate an administrator")
+	}
+
+	actor := &util.Actor{
+		UserID:       claims.UserID,
+		Email:        claims.Email,
+		TokenVersion: claims.TokenVersion,
+		SessionID:    claims.SessionID,
+	}
+
+	accessToken, impersonationClaims, err := util.GenerateImpersonationJWT(user, actor, s.config.Impersonation.Expiry, &s.config.JWT)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, &dto.AuthTokens{
+		AccessToken: accessToken,
+		CSRFToken:   util.GenerateCSRFToken(util.CSRFSubject(impersonationClaims), s.config.CSRF.Expiry, s.config.CSRF.Secret),
+	}, nil
+}
+
+func (s *impersonationServiceImpl) Stop(ctx context.Context, claims *util.JWTClaims) error {
+	if !claims.IsImpersonation() {
+		return errors.New("not impersonating a user")
+	}
+
+	err := s.tokenService.Revoke(ctx, claims)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionImpersonationStop, claims.Actor.UserID, "user", claims.UserID).WithError(err))
+	return err
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestImpersonationService_Start_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+		Impersonation: config.ImpersonationConfig{
+			Expiry: 10 * time.Minute,
+		},
+	}
+
+	impersonationService := NewImpersonationService(mockUserRepo, mockTokenService, mockAuditService, cfg)
+
+	// Test data
+	adminClaims := &util.JWTClaims{
+		UserID:       primitive.NewObjectID().Hex(),
+		Email:        "admin@example.com",
+		Role:         string(model.RoleAdmin),
+		TokenVersion: 2,
+		SessionID:    primitive.NewObjectID().Hex(),
+	}
+	user := &model.User{
+		ID:           primitive.NewObjectID(),
+		Email:        "customer@example.com",
+		Role:         model.RoleMember,
+		TokenVersion: 5,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionImpersonationStart &&
+				entry.Outcome == model.AuditOutcomeSuccess &&
+				entry.ActorID.Hex() == adminClaims.UserID &&
+				entry.TargetID == user.ID.Hex() &&
+				entry.Metadata["reason"] == "ticket #4711"
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, tokens, err := impersonationService.Start(context.Background(), adminClaims, user.ID.Hex(), "ticket #4711")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user, result)
+
+	claims, err := util.ValidateJWT(tokens.AccessToken, &cfg.JWT)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID.Hex(), claims.UserID)
+	assert.Equal(t, string(model.RoleMember), claims.Role)
+	assert.Equal(t, 5, claims.TokenVersion)
+	assert.Empty(t, claims.SessionID)
+	assert.Equal(t, &util.Actor{
+		UserID:       adminClaims.UserID,
+		Email:        adminClaims.Email,
+		TokenVersion: 2,
+		SessionID:    adminClaims.SessionID,
+	}, claims.Actor)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), claims.ExpiresAt.Time, 5*time.Second)
+
+	// the CSRF token only works together with the impersonation token
+	assert.True(t, util.ValidateCSRFToken(tokens.CSRFToken, util.CSRFSubject(claims), cfg.CSRF.Secret))
+	assert.False(t, util.ValidateCSRFToken(tokens.CSRFToken, util.CSRFSubject(adminClaims), cfg.CSRF.Secret))
+	assert.Empty(t, tokens.RefreshToken)
+}
+
+func TestImpersonationService_Start_Administrator(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+		Impersonation: config.ImpersonationConfig{
+			Expiry: 10 * time.Minute,
+		},
+	}
+
+	impersonationService := NewImpersonationService(mockUserRepo, mockTokenService, mockAuditService, cfg)
+
+	// Test data
+	adminClaims := &util.JWTClaims{UserID: primitive.NewObjectID().Hex(), Role: string(model.RoleAdmin)}
+	otherAdmin := &model.User{ID: primitive.NewObjectID(), Role: model.RoleAdmin}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, otherAdmin.ID).
+		Return(otherAdmin, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionImpersonationStart && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, tokens, err := impersonationService.Start(context.Background(), adminClaims, otherAdmin.ID.Hex(), "testing")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "cannot impersonate an administrator", err.Error())
+	assert.Nil(t, result)
+	assert.Nil(t, tokens)
+}
+
+func TestImpersonationService_Start_WhileImpersonating(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+		Impersonation: config.ImpersonationConfig{
+			Expiry: 10 * time.Minute,
+		},
+	}
+
+	impersonationService := NewImpersonationService(mockUserRepo, mockTokenService, mockAuditService, cfg)
+
+	// Test data
+	claims := &util.JWTClaims{
+		UserID: primitive.NewObjectID().Hex(),
+		Actor:  &util.Actor{UserID: primitive.NewObjectID().Hex()},
+	}
+
+	// Mock expectations
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionImpersonationStart && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	_, _, err := impersonationService.Start(context.Background(), claims, primitive.NewObjectID().Hex(), "testing")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "already impersonating a user", err.Error())
+}
+
+func TestImpersonationService_Stop_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+		Impersonation: config.ImpersonationConfig{
+			Expiry: 10 * time.Minute,
+		},
+	}
+
+	impersonationService := NewImpersonationService(mockUserRepo, mockTokenService, mockAuditService, cfg)
+
+	// Test data
+	adminID := primitive.NewObjectID()
+	claims := newTestClaims(primitive.NewObjectID(), 0)
+	claims.Actor = &util.Actor{UserID: adminID.Hex()}
+
+	// Mock expectations
+	mockTokenService.EXPECT().
+		Revoke(mock.Anything, claims).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionImpersonationStop &&
+				entry.Outcome == model.AuditOutcomeSuccess &&
+				entry.ActorID == adminID &&
+				entry.TargetID == claims.UserID
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := impersonationService.Stop(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestImpersonationService_Stop_NotImpersonating(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret: "test-secret",
+			Expiry: 15 * time.Minute,
+		},
+		CSRF: config.CSRFConfig{
+			Secret: "csrf-secret",
+			Expiry: time.Hour,
+		},
+		Impersonation: config.ImpersonationConfig{
+			Expiry: 10 * time.Minute,
+		},
+	}
+
+	impersonationService := NewImpersonationService(mockUserRepo, mockTokenService, mockAuditService, cfg)
+
+	// Execute
+	err := impersonationService.Stop(context.Background(), newTestClaims(primitive.NewObjectID(), 0))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "not impersonating a user", err.Error())
+}
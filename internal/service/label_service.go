@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LabelService interface {
+	Create(ctx context.Context, userID string, req dto.CreateLabelRequest) (*model.Label, error)
+	List(ctx context.Context) ([]model.Label, error)
+	Update(ctx context.Context, userID, id string, req dto.UpdateLabelRequest) (*model.Label, error)
+	Delete(ctx context.Context, userID, id string) error
+}
+
+type labelServiceImpl struct {
+	labelRepo    repository.LabelRepository
+	taskRepo     repository.TaskRepository
+	auditService AuditService
+}
+
+func NewLabelService(labelRepo repository.LabelRepository, taskRepo repository.TaskRepository, auditService AuditService) LabelService {
+	return &labelServiceImpl{
+		labelRepo:    labelRepo,
+		taskRepo:     taskRepo,
+		auditService: auditService,
+	}
+}
+
+func (s *labelServiceImpl) Create(ctx context.Context, userID string, req dto.CreateLabelRequest) (*model.Label, error) {
+	label, err := s.create(ctx, userID, req)
+
+	targetID := ""
+	if label != nil {
+		targetID = label.ID.Hex()
+	}
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionLabelCreate, userID, "label", targetID).WithError(err).WithMetadata("name", req.Name))
+
+	return label, err
+}
+
+func (s *labelServiceImpl) create(ctx context.Context, userID string, req dto.CreateLabelRequest) (*model.Label, error) {
+	creatorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("label name is required")
+	}
+
+	label := model.NewLabel(name, strings.ToLower(req.Color), creatorID)
+
+	if err := s.labelRepo.Create(ctx, label); err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+func (s *labelServiceImpl) List(ctx context.Context) ([]model.Label, error) {
+	return s.labelRepo.FindAll(ctx)
+}
+
+func (s *labelServiceImpl) findLabel(ctx context.Context, id string) (*model.Label, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid label ID")
+	}
+
+	label, err := s.labelRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if label == nil {
+		return nil, errors.New("label not found")
+	}
+
+	return label, nil
+}
+
+func (s *labelServiceImpl) Update(ctx context.Context, userID, id string, req dto.UpdateLabelRequest) (*model.Label, error) {
+	label, previousName, err := s.update(ctx, id, req)
+
+	entry := model.NewAuditLog(model.AuditActionLabelUpdate, userID, "label", id).WithError(err)
+	if label != nil && previousName != label.Name {
+		entry.WithMetadata("previous_name", previousName).WithMetadata("name", label.Name)
+	}
+	s.auditService.Record(ctx, entry)
+
+	return label, err
+}
+
+// update renames the tags on tasks before the label itself, so a failure
+// leaves the label in place and the rename can simply be retried.
+func (s *labelServiceImpl) update(ctx context.Context, id string, req dto.UpdateLabelRequest) (*model.Label, string, error) {
+	label, err := s.findLabel(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	previousName := label.Name
+
+	if req.Color != nil {
+		label.Color = strings.ToLower(*req.Color)
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, previousName, errors.New("label name is required")
+		}
+
+		if name != label.Name {
+			existing, err := s.labelRepo.FindByNames(ctx, []string{name})
+			if err != nil {
+				return nil, previousName, err
+			}
+
+			if len(existing) > 0 {
+				return nil, previousName, errors.New("label already exists")
+			}
+
+			if err := s.taskRepo.RenameTag(ctx, label.Name, name); err != nil {
+				return nil, previousName, err
+			}
+
+			label.Name = name
+		}
+	}
+
+	if err := s.labelRepo.Update(ctx, label); err != nil {
+		return nil, previousName, err
+	}
+
+	return label, previousName, nil
+}
+
+func (s *labelServiceImpl) Delete(ctx context.Context, userID, id string) error {
+	name, err := s.delete(ctx, id)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionLabelDelete, userID, "label", id).WithError(err).WithMetadata("name", name))
+	return err
+}
+
+// delete removes the tag from tasks before the label itself, so a failure
+// leaves the label in place and the deletion can simply be retried.
+func (s *labelServiceImpl) delete(ctx context.Context, id string) (string, error) {
+	label, err := s.findLabel(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.taskRepo.RemoveTag(ctx, label.Name); err != nil {
+		return label.Name, err
+	}
+
+	return label.Name, s.labelRepo.Delete(ctx, label.ID)
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestLabelService_Create_Success(t *testing.T) {
+	// Setup
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	labelService := NewLabelService(mockLabelRepo, mockTaskRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	req := dto.CreateLabelRequest{
+		Name:  " frontend ",
+		Color: "#1E90FF",
+	}
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(label *model.Label) bool {
+			return label.Name == "frontend" && label.Color == "#1e90ff" && label.CreatedBy == userID
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLabelCreate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	label, err := labelService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "frontend", label.Name)
+}
+
+func TestLabelService_Update_RenamePropagatesToTasks(t *testing.T) {
+	// Setup
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	labelService := NewLabelService(mockLabelRepo, mockTaskRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	label := model.NewLabel("bug", "#ff0000", userID)
+	label.ID = primitive.NewObjectID()
+	name := "defect"
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		FindByID(mock.Anything, label.ID).
+		Return(label, nil).
+		Once()
+
+	mockLabelRepo.EXPECT().
+		FindByNames(mock.Anything, []string{"defect"}).
+		Return([]model.Label{}, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RenameTag(mock.Anything, "bug", "defect").
+		Return(nil).
+		Once()
+
+	mockLabelRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(l *model.Label) bool {
+			return l.Name == "defect"
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLabelUpdate &&
+				entry.Outcome == model.AuditOutcomeSuccess &&
+				entry.Metadata["previous_name"] == "bug"
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := labelService.Update(context.Background(), userID.Hex(), label.ID.Hex(), dto.UpdateLabelRequest{Name: &name})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "defect", result.Name)
+}
+
+func TestLabelService_Update_NameTaken(t *testing.T) {
+	// Setup
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	labelService := NewLabelService(mockLabelRepo, mockTaskRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	label := model.NewLabel("bug", "#ff0000", userID)
+	label.ID = primitive.NewObjectID()
+	name := "frontend"
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		FindByID(mock.Anything, label.ID).
+		Return(label, nil).
+		Once()
+
+	mockLabelRepo.EXPECT().
+		FindByNames(mock.Anything, []string{"frontend"}).
+		Return([]model.Label{{ID: primitive.NewObjectID(), Name: "frontend"}}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLabelUpdate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := labelService.Update(context.Background(), userID.Hex(), label.ID.Hex(), dto.UpdateLabelRequest{Name: &name})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "label already exists", err.Error())
+}
+
+func TestLabelService_Delete_RemovesTagFromTasks(t *testing.T) {
+	// Setup
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	labelService := NewLabelService(mockLabelRepo, mockTaskRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	label := model.NewLabel("bug", "#ff0000", userID)
+	label.ID = primitive.NewObjectID()
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		FindByID(mock.Anything, label.ID).
+		Return(label, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RemoveTag(mock.Anything, "bug").
+		Return(nil).
+		Once()
+
+	mockLabelRepo.EXPECT().
+		Delete(mock.Anything, label.ID).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLabelDelete && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := labelService.Delete(context.Background(), userID.Hex(), label.ID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestLabelService_Delete_KeepsLabelWhenTasksFail(t *testing.T) {
+	// Setup
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	labelService := NewLabelService(mockLabelRepo, mockTaskRepo, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	label := model.NewLabel("bug", "#ff0000", userID)
+	label.ID = primitive.NewObjectID()
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		FindByID(mock.Anything, label.ID).
+		Return(label, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RemoveTag(mock.Anything, "bug").
+		Return(errors.New("connection reset")).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionLabelDelete && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := labelService.Delete(context.Background(), userID.Hex(), label.ID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "connection reset", err.Error())
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const mfaTokenPrefix = "mfa"
+
+// MFARequiredError is returned by Login when the password was correct but the
+// account has MFA enabled. Token has to be exchanged together with a code via
+// CompleteMFALogin.
+type MFARequiredError struct {
+	Token     string
+	ExpiresIn time.Duration
+}
+
+func (e *MFARequiredError) Error() string {
+	return "mfa verification required"
+}
+
+// the payload is "mfa:<expiry>:<user id>"; the prefix keeps these tokens from
+// being accepted anywhere else that signs with the same secret
+func (s *authServiceImpl) generateMFAToken(user *model.User) string {
+	expiresAt := time.Now().Add(s.config.MFA.PendingTokenExpiry).Unix()
+	payload := mfaTokenPrefix + ":" + strconv.FormatInt(expiresAt, 10) + ":" + user.ID.Hex()
+	return util.SignToken(payload, s.config.MFA.Secret)
+}
+
+func (s *authServiceImpl) parseMFAToken(token string) (primitive.ObjectID, bool) {
+	payload, ok := util.VerifySignedToken(token, s.config.MFA.Secret)
+	if !ok {
+		return primitive.NilObjectID, false
+	}
+
+	parts := strings.Split(payload, ":")
+	if len(parts) != 3 || parts[0] != mfaTokenPrefix {
+		return primitive.NilObjectID, false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return primitive.NilObjectID, false
+	}
+
+	userID, err := primitive.ObjectIDFromHex(parts[2])
+	if err != nil {
+		return primitive.NilObjectID, false
+	}
+
+	return userID, true
+}
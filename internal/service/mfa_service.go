@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// number of 30 second steps a TOTP code may be off by to tolerate clock drift
+const totpSkew = 1
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+type MFAService interface {
+	Enroll(ctx context.Context, userID string) (string, string, error)
+	Confirm(ctx context.Context, userID, code string) ([]string, error)
+	Disable(ctx context.Context, userID, password, code string) error
+	Reset(ctx context.Context, userID string) error
+	Verify(ctx context.Context, user *model.User, code string) (bool, error)
+}
+
+type mfaServiceImpl struct {
+	userRepo repository.UserRepository
+	config   *config.Config
+}
+
+func NewMFAService(userRepo repository.UserRepository, config *config.Config) MFAService {
+	return &mfaServiceImpl{
+		userRepo: userRepo,
+		config:   config,
+	}
+}
+
+// Enroll starts (or restarts) TOTP enrollment by storing a new secret. MFA is
+// not enforced until the secret is confirmed with a valid code.
+func (s *mfaServiceImpl) Enroll(ctx context.Context, userID string) (string, string, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if user.MFA.Enabled {
+		return "", "", errors.New("mfa is already enabled")
+	}
+
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	user.MFA = model.MFA{Secret: secret}
+
+	if err := s.userRepo.UpdateMFA(ctx, user.ID, user.MFA); err != nil {
+		return "", "", err
+	}
+
+	return secret, util.TOTPURI(s.config.MFA.Issuer, user.Email, secret), nil
+}
+
+// Confirm enables MFA once the user proves their authenticator works, and
+// returns the recovery codes. They are only stored hashed, so this is the
+// only time they can be shown.
+func (s *mfaServiceImpl) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFA.Enabled {
+		return nil, errors.New("mfa is already enabled")
+	}
+
+	if user.MFA.Secret == "" {
+		return nil, errors.New("mfa enrollment has not been started")
+	}
+
+	step, ok := util.ValidateTOTPCode(user.MFA.Secret, normalizeMFACode(code), time.Now(), totpSkew)
+	if !ok {
+		return nil, errors.New("invalid mfa code")
+	}
+
+	recoveryCodes, hashedCodes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user.MFA.Enabled = true
+	user.MFA.EnabledAt = &now
+	user.MFA.LastUsedStep = step
+	user.MFA.RecoveryCodes = hashedCodes
+
+	if err := s.userRepo.UpdateMFA(ctx, user.ID, user.MFA); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// Disable turns MFA off after the user proves who they are with their password.
+// Accounts created through an identity provider have no password, so they
+// prove it with a current TOTP code or a recovery code instead.
+func (s *mfaServiceImpl) Disable(ctx context.Context, userID, password, code string) error {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.MFA.Enabled {
+		return errors.New("mfa is not enabled")
+	}
+
+	if user.Password != "" {
+		if match, _ := util.VerifyPassword(password, user.Password, &s.config.Password); !match {
+			return errors.New("password is incorrect")
+		}
+	} else {
+		ok, err := s.Verify(ctx, user, code)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("invalid mfa code")
+		}
+	}
+
+	user.MFA = model.MFA{}
+
+	return s.userRepo.UpdateMFA(ctx, user.ID, user.MFA)
+}
+
+// Reset removes MFA from an account regardless of its state, for admins to
+// help users who lost both their authenticator and recovery codes.
+func (s *mfaServiceImpl) Reset(ctx context.Context, userID string) error {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	user.MFA = model.MFA{}
+
+	return s.userRepo.UpdateMFA(ctx, user.ID, user.MFA)
+}
+
+// Verify accepts either a current TOTP code or an unused recovery code. Both
+// are single-use: a TOTP step cannot be replayed and a recovery code is
+// removed once redeemed.
+func (s *mfaServiceImpl) Verify(ctx context.Context, user *model.User, code string) (bool, error) {
+	if !user.MFA.Enabled {
+		return false, nil
+	}
+
+	code = normalizeMFACode(code)
+
+	if step, ok := util.ValidateTOTPCode(user.MFA.Secret, code, time.Now(), totpSkew); ok {
+		return s.userRepo.UpdateMFALastUsedStep(ctx, user.ID, step)
+	}
+
+	return s.userRepo.ConsumeRecoveryCode(ctx, user.ID, util.HashToken(normalizeRecoveryCode(code)))
+}
+
+func (s *mfaServiceImpl) findUser(ctx context.Context, userID string) (*model.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	return user, nil
+}
+
+func (s *mfaServiceImpl) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, s.config.MFA.RecoveryCodeCount)
+	hashes := make([]string, s.config.MFA.RecoveryCodeCount)
+
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		codes[i] = raw[:4] + "-" + raw[4:]
+		hashes[i] = util.HashToken(raw)
+	}
+
+	return codes, hashes, nil
+}
+
+func normalizeMFACode(code string) string {
+	return strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(code, "-", ""))
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMFAService_Enroll_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateMFA(mock.Anything, user.ID, mock.MatchedBy(func(mfa model.MFA) bool {
+			return mfa.Secret != "" && !mfa.Enabled
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	secret, uri, err := mfaService.Enroll(context.Background(), user.ID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotEmpty(t, secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/MileApp:test@example.com?"))
+	assert.Contains(t, uri, "secret="+secret)
+}
+
+func TestMFAService_Confirm_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	code, _ := util.GenerateTOTPCode(secret, util.TOTPStep(time.Now()))
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+		MFA:   model.MFA{Secret: secret},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateMFA(mock.Anything, user.ID, mock.MatchedBy(func(mfa model.MFA) bool {
+			return mfa.Enabled && len(mfa.RecoveryCodes) == 10 && mfa.LastUsedStep > 0
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	recoveryCodes, err := mfaService.Confirm(context.Background(), user.ID.Hex(), code)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, recoveryCodes, 10)
+	// only hashes are stored
+	assert.NotContains(t, user.MFA.RecoveryCodes, recoveryCodes[0])
+}
+
+func TestMFAService_Confirm_InvalidCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Secret: secret},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	recoveryCodes, err := mfaService.Confirm(context.Background(), user.ID.Hex(), "000000x")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, recoveryCodes)
+	assert.Equal(t, "invalid mfa code", err.Error())
+}
+
+func TestMFAService_Verify_TOTPCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	step := util.TOTPStep(time.Now())
+	code, _ := util.GenerateTOTPCode(secret, step)
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Enabled: true, Secret: secret},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		UpdateMFALastUsedStep(mock.Anything, user.ID, step).
+		Return(true, nil).
+		Once()
+
+	// Execute
+	valid, err := mfaService.Verify(context.Background(), user, code)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestMFAService_Verify_ReplayedTOTPCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	step := util.TOTPStep(time.Now())
+	code, _ := util.GenerateTOTPCode(secret, step)
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Enabled: true, Secret: secret, LastUsedStep: step},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		UpdateMFALastUsedStep(mock.Anything, user.ID, step).
+		Return(false, nil).
+		Once()
+
+	// Execute
+	valid, err := mfaService.Verify(context.Background(), user, code)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestMFAService_Verify_RecoveryCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		MFA: config.MFAConfig{
+			Issuer:            "MileApp",
+			RecoveryCodeCount: 10,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Enabled: true, Secret: secret, RecoveryCodes: []string{util.HashToken("abcd2345")}},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		ConsumeRecoveryCode(mock.Anything, user.ID, util.HashToken("abcd2345")).
+		Return(true, nil).
+		Once()
+
+	// Execute
+	valid, err := mfaService.Verify(context.Background(), user, "ABCD-2345")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestMFAService_Disable_WithPassword(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{
+		Password: config.PasswordConfig{
+			Argon2Memory:      8 * 1024,
+			Argon2Iterations:  1,
+			Argon2Parallelism: 1,
+		},
+	}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	hashedPassword, _ := util.HashPassword("password123", &cfg.Password)
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Password: hashedPassword,
+		MFA:      model.MFA{Enabled: true, Secret: "secret"},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Twice()
+
+	mockUserRepo.EXPECT().
+		UpdateMFA(mock.Anything, user.ID, model.MFA{}).
+		Return(nil).
+		Once()
+
+	// Execute
+	wrongErr := mfaService.Disable(context.Background(), user.ID.Hex(), "wrong-password", "")
+	err := mfaService.Disable(context.Background(), user.ID.Hex(), "password123", "")
+
+	// Assert
+	assert.EqualError(t, wrongErr, "password is incorrect")
+	assert.NoError(t, err)
+}
+
+func TestMFAService_Disable_PasswordlessAccountWithCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	step := util.TOTPStep(time.Now())
+	code, _ := util.GenerateTOTPCode(secret, step)
+	// accounts created through an identity provider have no password
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Enabled: true, Secret: secret},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateMFALastUsedStep(mock.Anything, user.ID, step).
+		Return(true, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateMFA(mock.Anything, user.ID, model.MFA{}).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := mfaService.Disable(context.Background(), user.ID.Hex(), "", code)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestMFAService_Disable_PasswordlessAccountInvalidCode(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	cfg := &config.Config{}
+
+	mfaService := NewMFAService(mockUserRepo, cfg)
+
+	// Test data
+	secret, _ := util.GenerateTOTPSecret()
+	user := &model.User{
+		ID:  primitive.NewObjectID(),
+		MFA: model.MFA{Enabled: true, Secret: secret},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		ConsumeRecoveryCode(mock.Anything, user.ID, util.HashToken("wrongcode")).
+		Return(false, nil).
+		Once()
+
+	// Execute
+	err := mfaService.Disable(context.Background(), user.ID.Hex(), "", "wrong-code")
+
+	// Assert
+	assert.EqualError(t, err, "invalid mfa code")
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/pkg/oidc"
+)
+
+const oidcFlowTokenPrefix = "oidc"
+
+type OIDCService interface {
+	BeginLogin(ctx context.Context, provider string) (string, string, error)
+	CompleteLogin(ctx context.Context, provider, code, state, flowToken, ip, userAgent string) (*model.User, *dto.AuthTokens, error)
+}
+
+type oidcServiceImpl struct {
+	userRepo     repository.UserRepository
+	authService  AuthService
+	tokenService TokenService
+	providers    map[string]*oidc.Provider
+	config       *config.Config
+}
+
+func NewOIDCService(userRepo repository.UserRepository, authService AuthService, tokenService TokenService, config *config.Config) OIDCService {
+	providers := make(map[string]*oidc.Provider, len(config.OIDC.Providers))
+	for _, provider := range config.OIDC.Providers {
+		providers[provider.Name] = oidc.NewProvider(oidc.Config{
+			IssuerURL:    provider.IssuerURL,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		}, nil)
+	}
+
+	return &oidcServiceImpl{
+		userRepo:     userRepo,
+		authService:  authService,
+		tokenService: tokenService,
+		providers:    providers,
+		config:       config,
+	}
+}
+
+// BeginLogin returns the provider URL to send the user to and a flow token
+// that carries state, nonce and PKCE verifier until the user comes back. The
+// flow token has to stay with the user agent, e.g. in an HttpOnly cookie.
+func (s *oidcServiceImpl) BeginLogin(ctx context.Context, provider string) (string, string, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return "", "", errors.New("unknown identity provider")
+	}
+
+	state, err := util.GenerateRandomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	nonce, err := util.GenerateRandomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	codeVerifier, err := util.GenerateRandomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(codeVerifier))
+	if err != nil {
+		return "", "", errors.New("identity provider is unavailable")
+	}
+
+	expiresAt := time.Now().Add(s.config.OIDC.FlowExpiry).Unix()
+	payload := strings.Join([]string{oidcFlowTokenPrefix, provider, strconv.FormatInt(expiresAt, 10), state, nonce, codeVerifier}, ":")
+
+	return authURL, util.SignToken(payload, s.config.OIDC.Secret), nil
+}
+
+// CompleteLogin handles the provider's redirect back: it checks the state,
+// exchanges the code, verifies the ID token and logs in the user owning the
+// verified email address, creating the account if the provider allows it.
+func (s *oidcServiceImpl) CompleteLogin(ctx context.Context, provider, code, state, flowToken, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, nil, errors.New("unknown identity provider")
+	}
+
+	nonce, codeVerifier, ok := s.parseFlowToken(flowToken, provider, state)
+	if !ok {
+		return nil, nil, errors.New("invalid or expired login state")
+	}
+
+	token, err := p.Exchange(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, nil, errors.New("identity provider login failed")
+	}
+
+	claims, err := p.VerifyIDToken(ctx, token.IDToken, nonce)
+	if err != nil {
+		return nil, nil, errors.New("identity provider login failed")
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, nil, errors.New("email address is not verified by the identity provider")
+	}
+
+	user, err := s.findOrCreateUser(ctx, provider, claims)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.authService.CompleteExternalLogin(ctx, user, ip, userAgent)
+}
+
+func (s *oidcServiceImpl) findOrCreateUser(ctx context.Context, provider string, claims *oidc.IDTokenClaims) (*model.User, error) {
+	user, err := s.userRepo.FindByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if user == nil {
+		if !s.allowsSignup(provider) {
+			return nil, errors.New("no account exists for this email")
+		}
+
+		// no password: the account can only be used through the provider until
+		// the user sets one via password reset
+		user = model.NewUser(claims.Email, "")
+		user.DisplayName = claims.Name
+		user.EmailVerifiedAt = &now
+
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return nil, err
+		}
+
+		return user, nil
+	}
+
+	if !user.IsEmailVerified() {
+		// whoever registered this unverified account may not own the address;
+		// the provider just proved that this user does, so drop the password
+		// and sessions set up by the previous party before linking
+		if err := s.userRepo.UpdatePassword(ctx, user.ID, ""); err != nil {
+			return nil, err
+		}
+
+		if err := s.userRepo.MarkEmailVerified(ctx, user.ID, now); err != nil {
+			return nil, err
+		}
+
+		if err := s.tokenService.RevokeAllForUser(ctx, user.ID.Hex()); err != nil {
+			return nil, err
+		}
+
+		// pick up the bumped token version
+		user, err = s.userRepo.FindByID(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, errors.New("no account exists for this email")
+		}
+	}
+
+	return user, nil
+}
+
+func (s *oidcServiceImpl) allowsSignup(provider string) bool {
+	for _, p := range s.config.OIDC.Providers {
+		if p.Name == provider {
+			return p.AllowSignup
+		}
+	}
+	return false
+}
+
+// the payload is "oidc:<provider>:<expiry>:<state>:<nonce>:<code verifier>"
+func (s *oidcServiceImpl) parseFlowToken(flowToken, provider, state string) (string, string, bool) {
+	payload, ok := util.VerifySignedToken(flowToken, s.config.OIDC.Secret)
+	if !ok {
+		return "", "", false
+	}
+
+	parts := strings.Split(payload, ":")
+	if len(parts) != 6 || parts[0] != oidcFlowTokenPrefix || parts[1] != provider {
+		return "", "", false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return "", "", false
+	}
+
+	if state == "" || subtle.ConstantTimeCompare([]byte(parts[3]), []byte(state)) != 1 {
+		return "", "", false
+	}
+
+	return parts[4], parts[5], true
+}
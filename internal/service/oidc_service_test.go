@@ -0,0 +1,504 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/jwks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const oidcTestClientID = "mileapp-client"
+
+// fakeOIDCProvider is a minimal OpenID Connect provider serving discovery,
+// JWKS and a token endpoint that checks the PKCE verifier.
+type fakeOIDCProvider struct {
+	server *httptest.Server
+	keySet *jwks.KeySet
+
+	mu            sync.Mutex
+	codeChallenge string
+	nonce         string
+	claims        jwt.MapClaims
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keySet, err := jwks.NewKeySet(privateKey)
+	assert.NoError(t, err)
+
+	fake := &fakeOIDCProvider{keySet: keySet}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidc.Discovery{
+			Issuer:                fake.server.URL,
+			AuthorizationEndpoint: fake.server.URL + "/authorize",
+			TokenEndpoint:         fake.server.URL + "/token",
+			JWKSURI:               fake.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(keySet.JWKS())
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		if r.PostFormValue("code") != "valid-code" || oidc.CodeChallenge(r.PostFormValue("code_verifier")) != fake.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		claims := jwt.MapClaims{
+			"iss":   fake.server.URL,
+			"aud":   oidcTestClientID,
+			"sub":   "subject-1",
+			"nonce": fake.nonce,
+			"iat":   time.Now().Unix(),
+			"exp":   time.Now().Add(5 * time.Minute).Unix(),
+		}
+		for k, v := range fake.claims {
+			claims[k] = v
+		}
+
+		idToken, _ := keySet.Sign(claims)
+		_ = json.NewEncoder(w).Encode(oidc.TokenResponse{AccessToken: "at", TokenType: "Bearer", IDToken: idToken})
+	})
+
+	fake.server = httptest.NewServer(mux)
+	t.Cleanup(fake.server.Close)
+
+	return fake
+}
+
+// authorize plays the part of the user logging in at the provider and
+// returns the state it would redirect back with.
+func (f *fakeOIDCProvider) authorize(t *testing.T, authURL string, claims jwt.MapClaims) string {
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+
+	query := parsed.Query()
+	assert.Equal(t, oidcTestClientID, query.Get("client_id"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.codeChallenge = query.Get("code_challenge")
+	f.nonce = query.Get("nonce")
+	f.claims = claims
+
+	return query.Get("state")
+}
+
+func TestOIDCService_CompleteLogin_ExistingUser(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Test data
+	verifiedAt := time.Now().Add(-time.Hour)
+	user := &model.User{
+		ID:              primitive.NewObjectID(),
+		Email:           "jane@example.com",
+		EmailVerifiedAt: &verifiedAt,
+	}
+	tokens := &dto.AuthTokens{AccessToken: "access", CSRFToken: "csrf", RefreshToken: "refresh"}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "jane@example.com").
+		Return(user, nil).
+		Once()
+
+	mockAuthService.EXPECT().
+		CompleteExternalLogin(mock.Anything, user, "203.0.113.10", "test-agent").
+		Return(user, tokens, nil).
+		Once()
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, jwt.MapClaims{"email": "jane@example.com", "email_verified": true})
+
+	resultUser, resultTokens, err := oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user, resultUser)
+	assert.Equal(t, tokens, resultTokens)
+}
+
+func TestOIDCService_CompleteLogin_ProvisionsUser(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+					AllowSignup: true,
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "new@example.com").
+		Return(nil, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+			return u.Email == "new@example.com" && u.Password == "" && u.DisplayName == "New User" &&
+				u.IsEmailVerified() && u.Role == model.RoleMember
+		})).
+		Return(nil).
+		Once()
+
+	mockAuthService.EXPECT().
+		CompleteExternalLogin(mock.Anything, mock.AnythingOfType("*model.User"), "203.0.113.10", "test-agent").
+		RunAndReturn(func(ctx context.Context, u *model.User, ip, userAgent string) (*model.User, *dto.AuthTokens, error) {
+			return u, &dto.AuthTokens{AccessToken: "access"}, nil
+		}).
+		Once()
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, jwt.MapClaims{"email": "new@example.com", "email_verified": true, "name": "New User"})
+
+	resultUser, _, err := oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "new@example.com", resultUser.Email)
+}
+
+func TestOIDCService_CompleteLogin_SignupDisabled(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "new@example.com").
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, jwt.MapClaims{"email": "new@example.com", "email_verified": true})
+
+	_, _, err = oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.EqualError(t, err, "no account exists for this email")
+}
+
+func TestOIDCService_CompleteLogin_TakesOverUnverifiedAccount(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    "jane@example.com",
+		Password: "hash-set-by-someone-else",
+	}
+	reloaded := &model.User{ID: user.ID, Email: user.Email, TokenVersion: 1}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "jane@example.com").
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdatePassword(mock.Anything, user.ID, "").
+		Return(nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		MarkEmailVerified(mock.Anything, user.ID, mock.AnythingOfType("time.Time")).
+		Return(nil).
+		Once()
+
+	mockTokenService.EXPECT().
+		RevokeAllForUser(mock.Anything, user.ID.Hex()).
+		Return(nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(reloaded, nil).
+		Once()
+
+	mockAuthService.EXPECT().
+		CompleteExternalLogin(mock.Anything, reloaded, "203.0.113.10", "test-agent").
+		Return(reloaded, &dto.AuthTokens{AccessToken: "access"}, nil).
+		Once()
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, jwt.MapClaims{"email": "jane@example.com", "email_verified": true})
+
+	resultUser, _, err := oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resultUser.TokenVersion)
+}
+
+func TestOIDCService_CompleteLogin_EmailNotVerified(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+					AllowSignup: true,
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, jwt.MapClaims{"email": "jane@example.com", "email_verified": false})
+
+	_, _, err = oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.EqualError(t, err, "email address is not verified by the identity provider")
+}
+
+func TestOIDCService_CompleteLogin_StateMismatch(t *testing.T) {
+	// Setup
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Execute
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	fake.authorize(t, authURL, jwt.MapClaims{"email": "jane@example.com", "email_verified": true})
+
+	_, _, err = oidcService.CompleteLogin(context.Background(), "corp", "valid-code", "forged-state", flowToken, "203.0.113.10", "test-agent")
+
+	// Assert
+	assert.EqualError(t, err, "invalid or expired login state")
+}
+
+// completeOIDCLoginWithClaims runs a login in which the provider issues an ID
+// token with the given claims on top of valid defaults.
+func completeOIDCLoginWithClaims(t *testing.T, overrides jwt.MapClaims) error {
+	fake := newFakeOIDCProvider(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					IssuerURL:   fake.server.URL,
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+					AllowSignup: true,
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	claims := jwt.MapClaims{"email": "jane@example.com", "email_verified": true}
+	for k, v := range overrides {
+		claims[k] = v
+	}
+
+	authURL, flowToken, err := oidcService.BeginLogin(context.Background(), "corp")
+	assert.NoError(t, err)
+	state := fake.authorize(t, authURL, claims)
+
+	_, _, err = oidcService.CompleteLogin(context.Background(), "corp", "valid-code", state, flowToken, "203.0.113.10", "test-agent")
+	return err
+}
+
+func TestOIDCService_CompleteLogin_WrongAudience(t *testing.T) {
+	// Execute
+	err := completeOIDCLoginWithClaims(t, jwt.MapClaims{"aud": "another-client"})
+
+	// Assert
+	assert.EqualError(t, err, "identity provider login failed")
+}
+
+func TestOIDCService_CompleteLogin_WrongIssuer(t *testing.T) {
+	// Execute
+	err := completeOIDCLoginWithClaims(t, jwt.MapClaims{"iss": "https://evil.example.com"})
+
+	// Assert
+	assert.EqualError(t, err, "identity provider login failed")
+}
+
+func TestOIDCService_CompleteLogin_WrongNonce(t *testing.T) {
+	// Execute
+	err := completeOIDCLoginWithClaims(t, jwt.MapClaims{"nonce": "replayed"})
+
+	// Assert
+	assert.EqualError(t, err, "identity provider login failed")
+}
+
+func TestOIDCService_CompleteLogin_ExpiredIDToken(t *testing.T) {
+	// Execute
+	err := completeOIDCLoginWithClaims(t, jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})
+
+	// Assert
+	assert.EqualError(t, err, "identity provider login failed")
+}
+
+func TestOIDCService_CompleteLogin_OtherAuthorizedParty(t *testing.T) {
+	// Execute
+	err := completeOIDCLoginWithClaims(t, jwt.MapClaims{"aud": []string{oidcTestClientID, "another-client"}, "azp": "another-client"})
+
+	// Assert
+	assert.EqualError(t, err, "identity provider login failed")
+}
+
+func TestOIDCService_BeginLogin_UnknownProvider(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockAuthService := mocks.NewMockAuthService(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{
+		OIDC: config.OIDCConfig{
+			Providers: []config.OIDCProviderConfig{
+				{
+					Name:        "corp",
+					ClientID:    oidcTestClientID,
+					RedirectURL: "http://localhost:8080/api/v1/auth/oidc/corp/callback",
+				},
+			},
+			FlowExpiry: 10 * time.Minute,
+			Secret:     "oidc-secret",
+		},
+	}
+	oidcService := NewOIDCService(mockUserRepo, mockAuthService, mockTokenService, cfg)
+
+	// Execute
+	_, _, err := oidcService.BeginLogin(context.Background(), "unknown")
+
+	// Assert
+	assert.EqualError(t, err, "unknown identity provider")
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/pkg/mailer"
+)
+
+const passwordResetTokenSize = 32
+
+type PasswordResetService interface {
+	RequestReset(ctx context.Context, email string) error
+	Reset(ctx context.Context, token, newPassword string) error
+}
+
+type passwordResetServiceImpl struct {
+	userRepo               repository.UserRepository
+	passwordResetTokenRepo repository.PasswordResetTokenRepository
+	tokenService           TokenService
+	mailer                 mailer.Mailer
+	config                 *config.Config
+	// background runs the work behind RequestReset after the response
+	background func(job func())
+}
+
+func NewPasswordResetService(userRepo repository.UserRepository, passwordResetTokenRepo repository.PasswordResetTokenRepository, tokenService TokenService, mailer mailer.Mailer, config *config.Config) PasswordResetService {
+	return &passwordResetServiceImpl{
+		userRepo:               userRepo,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		tokenService:           tokenService,
+		mailer:                 mailer,
+		config:                 config,
+		background:             func(job func()) { go job() },
+	}
+}
+
+// RequestReset emails a reset link when the address belongs to an account. The
+// lookup and the mail run in the background and failures are only logged, so
+// known and unknown addresses get the same answer in the same time and callers
+// cannot probe for accounts.
+func (s *passwordResetServiceImpl) RequestReset(ctx context.Context, email string) error {
+	ctx = context.WithoutCancel(ctx)
+	s.background(func() {
+		if err := s.requestReset(ctx, email); err != nil {
+			log.Printf("failed to handle password reset request: %v", err)
+		}
+	})
+
+	return nil
+}
+
+func (s *passwordResetServiceImpl) requestReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return nil
+	}
+
+	if err := s.sendResetLink(ctx, user); err != nil {
+		return fmt.Errorf("failed to send password reset link to user %s: %w", user.ID.Hex(), err)
+	}
+
+	return nil
+}
+
+func (s *passwordResetServiceImpl) sendResetLink(ctx context.Context, user *model.User) error {
+	rawToken, err := util.GenerateRandomToken(passwordResetTokenSize)
+	if err != nil {
+		return err
+	}
+
+	// only the most recently requested link stays valid
+	if err := s.passwordResetTokenRepo.InvalidateForUser(ctx, user.ID); err != nil {
+		return err
+	}
+
+	token := model.NewPasswordResetToken(user.ID, util.HashToken(rawToken), s.config.PasswordReset.Expiry)
+	if err := s.passwordResetTokenRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"We received a request to reset your password.\n\nOpen the link below to choose a new one. It expires in %d minutes.\n\n%s\n\nIf you did not request this, you can ignore this email.",
+			int(s.config.PasswordReset.Expiry.Minutes()),
+			s.resetLink(rawToken),
+		),
+	})
+}
+
+func (s *passwordResetServiceImpl) Reset(ctx context.Context, rawToken, newPassword string) error {
+	tokenHash := util.HashToken(rawToken)
+
+	// the token is only used up once the new password is accepted, so a
+	// password rejected by the policy doesn't require a new reset email
+	token, err := s.passwordResetTokenRepo.FindValid(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	if err := util.ValidatePassword(newPassword, user.Email, &s.config.Password); err != nil {
+		return err
+	}
+
+	hashedPassword, err := util.HashPassword(newPassword, &s.config.Password)
+	if err != nil {
+		return err
+	}
+
+	consumed, err := s.passwordResetTokenRepo.Consume(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	if consumed == nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
+
+	return s.tokenService.RevokeAllForUser(ctx, user.ID.Hex())
+}
+
+func (s *passwordResetServiceImpl) resetLink(rawToken string) string {
+	return s.config.PasswordReset.URL + "?token=" + url.QueryEscape(rawToken)
+}
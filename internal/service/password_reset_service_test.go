@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/grachmannico95/mileapp-test-be/pkg/mailer"
+	"github.com/grachmannico95/mileapp-test-be/pkg/password"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPasswordResetService_RequestReset_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		PasswordReset: config.PasswordResetConfig{
+			Expiry: 30 * time.Minute,
+			URL:    "http://localhost:5173/reset-password",
+		},
+	}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+	// run the background work inline so the mocks can be asserted
+	passwordResetService.(*passwordResetServiceImpl).background = func(job func()) { job() }
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+	var storedHash string
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, user.Email).
+		Return(user, nil).
+		Once()
+
+	mockResetTokenRepo.EXPECT().
+		InvalidateForUser(mock.Anything, user.ID).
+		Return(nil).
+		Once()
+
+	mockResetTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.PasswordResetToken) bool {
+			storedHash = token.TokenHash
+			return token.UserID == user.ID && token.ExpiresAt.After(time.Now())
+		})).
+		Return(nil).
+		Once()
+
+	mockMailer.EXPECT().
+		Send(mock.Anything, mock.MatchedBy(func(msg mailer.Message) bool {
+			// the link carries the raw token while only its hash is stored
+			idx := strings.Index(msg.Body, cfg.PasswordReset.URL+"?token=")
+			if msg.To != user.Email || idx < 0 {
+				return false
+			}
+			rawToken := strings.Fields(msg.Body[idx+len(cfg.PasswordReset.URL+"?token="):])[0]
+			return util.HashToken(rawToken) == storedHash
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.RequestReset(context.Background(), user.Email)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_RequestReset_UnknownEmail(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+	// run the background work inline so the mocks can be asserted
+	passwordResetService.(*passwordResetServiceImpl).background = func(job func()) { job() }
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "nobody@example.com").
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.RequestReset(context.Background(), "nobody@example.com")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_RequestReset_UnknownEmailDoesTheSameWork(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+	var jobs []func()
+	passwordResetService.(*passwordResetServiceImpl).background = func(job func()) { jobs = append(jobs, job) }
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, "nobody@example.com").
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.RequestReset(context.Background(), "nobody@example.com")
+	callsBeforeResponse := len(mockUserRepo.Calls)
+	for _, job := range jobs {
+		job()
+	}
+
+	// Assert
+	// like for a known address, the lookup is left to a background job
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Zero(t, callsBeforeResponse)
+}
+
+func TestPasswordResetService_RequestReset_MailFailureIsHidden(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		PasswordReset: config.PasswordResetConfig{
+			Expiry: 30 * time.Minute,
+			URL:    "http://localhost:5173/reset-password",
+		},
+	}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+	// run the background work inline so the mocks can be asserted
+	passwordResetService.(*passwordResetServiceImpl).background = func(job func()) { job() }
+
+	// Test data
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, user.Email).
+		Return(user, nil).
+		Once()
+
+	mockResetTokenRepo.EXPECT().
+		InvalidateForUser(mock.Anything, user.ID).
+		Return(nil).
+		Once()
+
+	mockResetTokenRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*model.PasswordResetToken")).
+		Return(nil).
+		Once()
+
+	mockMailer.EXPECT().
+		Send(mock.Anything, mock.AnythingOfType("mailer.Message")).
+		Return(errors.New("smtp unavailable")).
+		Once()
+
+	// Execute
+	err := passwordResetService.RequestReset(context.Background(), user.Email)
+
+	// Assert
+	// the caller sees the same result as for an unknown address
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_Reset_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+
+	// Test data
+	rawToken := "reset-token"
+	newPassword := "NewPassword123"
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    "test@example.com",
+		Password: "old-hash",
+	}
+	resetToken := model.NewPasswordResetToken(user.ID, util.HashToken(rawToken), 30*time.Minute)
+
+	// Mock expectations
+	mockResetTokenRepo.EXPECT().
+		FindValid(mock.Anything, util.HashToken(rawToken)).
+		Return(resetToken, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockResetTokenRepo.EXPECT().
+		Consume(mock.Anything, util.HashToken(rawToken)).
+		Return(resetToken, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdatePassword(mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+			match, _ := util.VerifyPassword(newPassword, hash, &cfg.Password)
+			return match
+		})).
+		Return(nil).
+		Once()
+
+	mockTokenService.EXPECT().
+		RevokeAllForUser(mock.Anything, user.ID.Hex()).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.Reset(context.Background(), rawToken, newPassword)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPasswordResetService_Reset_InvalidToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+
+	// Mock expectations
+	mockResetTokenRepo.EXPECT().
+		FindValid(mock.Anything, util.HashToken("used-or-expired")).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.Reset(context.Background(), "used-or-expired", "NewPassword123")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "invalid or expired reset token", err.Error())
+}
+
+func TestPasswordResetService_Reset_PolicyViolationKeepsToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockResetTokenRepo := mocks.NewMockPasswordResetTokenRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockMailer := mocks.NewMockMailer(t)
+	cfg := &config.Config{
+		Password: config.PasswordConfig{
+			MinLength:     8,
+			MaxLength:     128,
+			RequireDigit:  true,
+			DisallowEmail: true,
+		},
+	}
+
+	passwordResetService := NewPasswordResetService(mockUserRepo, mockResetTokenRepo, mockTokenService, mockMailer, cfg)
+
+	// Test data
+	rawToken := "reset-token"
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "jane.doe@example.com",
+	}
+	resetToken := model.NewPasswordResetToken(user.ID, util.HashToken(rawToken), 30*time.Minute)
+
+	// Mock expectations: the token must not be consumed
+	mockResetTokenRepo.EXPECT().
+		FindValid(mock.Anything, util.HashToken(rawToken)).
+		Return(resetToken, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	err := passwordResetService.Reset(context.Background(), rawToken, "Jane.Doe-Password")
+
+	// Assert
+	var policyErr *password.PolicyError
+	assert.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, []password.Violation{
+		{Rule: "digit", Message: "password must contain at least one digit"},
+		{Rule: "contains_email", Message: "password must not contain the email address"},
+	}, policyErr.Violations)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// PersonalAccessTokenPrefix marks personal access tokens so they can be
+	// told apart from JWTs in the Authorization header.
+	PersonalAccessTokenPrefix = "mpat_"
+
+	personalAccessTokenSize = 32
+	// how much of the token is kept in clear text to identify it in listings
+	personalAccessTokenDisplayLength = 12
+	// last-used bookkeeping is skipped when the token was seen this recently
+	// from the same IP, so busy scripts don't cause a write per request
+	personalAccessTokenTouchInterval = time.Minute
+)
+
+// ErrInvalidPersonalAccessToken is returned by Authenticate for unknown,
+// revoked, expired and outdated tokens.
+var ErrInvalidPersonalAccessToken = errors.New("invalid or expired personal access token")
+
+type PersonalAccessTokenService interface {
+	Create(ctx context.Context, userID, name string, scopes []string, expiresInDays int) (*model.PersonalAccessToken, string, error)
+	List(ctx context.Context, userID string) ([]model.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID, id string) error
+	Authenticate(ctx context.Context, rawToken, ip string) (*util.JWTClaims, error)
+}
+
+type personalAccessTokenServiceImpl struct {
+	userRepo                repository.UserRepository
+	personalAccessTokenRepo repository.PersonalAccessTokenRepository
+	auditService            AuditService
+	config                  *config.Config
+}
+
+func NewPersonalAccessTokenService(userRepo repository.UserRepository, personalAccessTokenRepo repository.PersonalAccessTokenRepository, auditService AuditService, config *config.Config) PersonalAccessTokenService {
+	return &personalAccessTokenServiceImpl{
+		userRepo:                userRepo,
+		personalAccessTokenRepo: personalAccessTokenRepo,
+		auditService:            auditService,
+		config:                  config,
+	}
+}
+
+// Create issues a new token and returns it in clear text alongside the stored
+// record. The clear text is never persisted, so this is the only chance to see it.
+func (s *personalAccessTokenServiceImpl) Create(ctx context.Context, userID, name string, scopes []string, expiresInDays int) (*model.PersonalAccessToken, string, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, "", errors.New("invalid user ID")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, ownerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if user == nil {
+		return nil, "", errors.New("user not found")
+	}
+
+	for _, scope := range scopes {
+		if !model.IsValidPermission(scope) {
+			return nil, "", errors.New("invalid scope: " + scope)
+		}
+		if !user.GetRole().HasPermission(model.Permission(scope)) {
+			return nil, "", errors.New("scope not permitted for your role: " + scope)
+		}
+	}
+
+	expiry := s.config.PersonalAccessToken.DefaultExpiry
+	if expiresInDays > 0 {
+		expiry = time.Duration(expiresInDays) * 24 * time.Hour
+	}
+
+	if expiry > s.config.PersonalAccessToken.MaxExpiry {
+		return nil, "", errors.New("expiry exceeds the maximum allowed lifetime")
+	}
+
+	random, err := util.GenerateRandomToken(personalAccessTokenSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rawToken := PersonalAccessTokenPrefix + random
+	token := model.NewPersonalAccessToken(ownerID, user.TokenVersion, strings.TrimSpace(name), util.HashToken(rawToken), rawToken[:personalAccessTokenDisplayLength], scopes, expiry)
+
+	if err := s.personalAccessTokenRepo.Create(ctx, token); err != nil {
+		return nil, "", err
+	}
+
+	return token, rawToken, nil
+}
+
+func (s *personalAccessTokenServiceImpl) List(ctx context.Context, userID string) ([]model.PersonalAccessToken, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	return s.personalAccessTokenRepo.FindByUser(ctx, ownerID)
+}
+
+func (s *personalAccessTokenServiceImpl) Revoke(ctx context.Context, userID, id string) error {
+	err := s.revoke(ctx, userID, id)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionPersonalAccessTokenRevoke, userID, "personal_access_token", id).WithError(err))
+	return err
+}
+
+func (s *personalAccessTokenServiceImpl) revoke(ctx context.Context, userID, id string) error {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return errors.New("invalid personal access token ID")
+	}
+
+	return s.personalAccessTokenRepo.Revoke(ctx, ownerID, objectID)
+}
+
+// Authenticate resolves a personal access token into claims for the token's
+// owner. The role is read from the user on every request, so a demotion takes
+// effect immediately; the token's scopes can only narrow it further. Tokens
+// created before the owner's sessions were last revoked (logout everywhere,
+// password change or reset, role change) are rejected.
+func (s *personalAccessTokenServiceImpl) Authenticate(ctx context.Context, rawToken, ip string) (*util.JWTClaims, error) {
+	token, err := s.personalAccessTokenRepo.FindByHash(ctx, util.HashToken(rawToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil || !token.IsActive() {
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil || token.TokenVersion != user.TokenVersion {
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	now := time.Now()
+	if token.LastUsedAt == nil || token.LastUsedIP != ip || now.Sub(*token.LastUsedAt) >= personalAccessTokenTouchInterval {
+		if err := s.personalAccessTokenRepo.UpdateLastUsed(ctx, token.ID, ip, now); err != nil {
+			return nil, err
+		}
+	}
+
+	claims := &util.JWTClaims{
+		UserID:       user.ID.Hex(),
+		Email:        user.Email,
+		Role:         string(user.GetRole()),
+		TokenVersion: user.TokenVersion,
+		Scopes:       token.Scopes,
+	}
+	claims.ID = token.ID.Hex()
+
+	return claims, nil
+}
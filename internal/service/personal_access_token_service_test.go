@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPersonalAccessTokenService_Create_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:           primitive.NewObjectID(),
+		Role:         model.RoleMember,
+		TokenVersion: 3,
+	}
+	scopes := []string{"tasks:read", "projects:write", "labels:read"}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockPATRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.PersonalAccessToken) bool {
+			return token.UserID == user.ID &&
+				token.TokenVersion == 3 &&
+				token.Name == "ci" &&
+				token.TokenHash != "" &&
+				token.ExpiresAt.After(time.Now().Add(89*24*time.Hour))
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	token, rawToken, err := patService.Create(context.Background(), user.ID.Hex(), " ci ", scopes, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rawToken, PersonalAccessTokenPrefix))
+	assert.Equal(t, util.HashToken(rawToken), token.TokenHash)
+	assert.True(t, strings.HasPrefix(rawToken, token.Prefix))
+	assert.Equal(t, scopes, token.Scopes)
+}
+
+func TestPersonalAccessTokenService_Create_ScopeBeyondRole(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:   primitive.NewObjectID(),
+		Role: model.RoleViewer,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	token, rawToken, err := patService.Create(context.Background(), user.ID.Hex(), "ci", []string{"tasks:write"}, 30)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, token)
+	assert.Empty(t, rawToken)
+	assert.Equal(t, "scope not permitted for your role: tasks:write", err.Error())
+}
+
+func TestPersonalAccessTokenService_Create_InvalidScope(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:   primitive.NewObjectID(),
+		Role: model.RoleAdmin,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	token, _, err := patService.Create(context.Background(), user.ID.Hex(), "ci", []string{"audit:read", "tasks:delete"}, 30)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, token)
+	assert.Equal(t, "invalid scope: tasks:delete", err.Error())
+}
+
+func TestPersonalAccessTokenService_Create_ExpiryTooLong(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:   primitive.NewObjectID(),
+		Role: model.RoleMember,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	token, _, err := patService.Create(context.Background(), user.ID.Hex(), "ci", []string{"tasks:read"}, 400)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, token)
+	assert.Equal(t, "expiry exceeds the maximum allowed lifetime", err.Error())
+}
+
+func TestPersonalAccessTokenService_Authenticate_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	rawToken := PersonalAccessTokenPrefix + "secret"
+	ip := "203.0.113.10"
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "test@example.com",
+		Role:  model.RoleMember,
+	}
+	token := model.NewPersonalAccessToken(user.ID, 0, "ci", util.HashToken(rawToken), "mpat_secret", []string{"tasks:read"}, time.Hour)
+	token.ID = primitive.NewObjectID()
+
+	// Mock expectations
+	mockPATRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(rawToken)).
+		Return(token, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockPATRepo.EXPECT().
+		UpdateLastUsed(mock.Anything, token.ID, ip, mock.AnythingOfType("time.Time")).
+		Return(nil).
+		Once()
+
+	// Execute
+	claims, err := patService.Authenticate(context.Background(), rawToken, ip)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID.Hex(), claims.UserID)
+	assert.Equal(t, "member", claims.Role)
+	assert.Equal(t, []string{"tasks:read"}, claims.Scopes)
+}
+
+func TestPersonalAccessTokenService_Authenticate_RecentlyUsedSkipsUpdate(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	rawToken := PersonalAccessTokenPrefix + "secret"
+	ip := "203.0.113.10"
+	user := &model.User{ID: primitive.NewObjectID()}
+	lastUsed := time.Now().Add(-10 * time.Second)
+	token := model.NewPersonalAccessToken(user.ID, 0, "ci", util.HashToken(rawToken), "mpat_secret", []string{"tasks:read"}, time.Hour)
+	token.LastUsedAt = &lastUsed
+	token.LastUsedIP = ip
+
+	// Mock expectations
+	mockPATRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(rawToken)).
+		Return(token, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	claims, err := patService.Authenticate(context.Background(), rawToken, ip)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, claims)
+}
+
+func TestPersonalAccessTokenService_Authenticate_Revoked(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	rawToken := PersonalAccessTokenPrefix + "secret"
+	revokedAt := time.Now()
+	token := model.NewPersonalAccessToken(primitive.NewObjectID(), 0, "ci", util.HashToken(rawToken), "mpat_secret", []string{"tasks:read"}, time.Hour)
+	token.RevokedAt = &revokedAt
+
+	// Mock expectations
+	mockPATRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(rawToken)).
+		Return(token, nil).
+		Once()
+
+	// Execute
+	claims, err := patService.Authenticate(context.Background(), rawToken, "203.0.113.10")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, claims)
+	assert.ErrorIs(t, err, ErrInvalidPersonalAccessToken)
+}
+
+func TestPersonalAccessTokenService_Authenticate_RevokedSessions(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockPATRepo := mocks.NewMockPersonalAccessTokenRepository(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		PersonalAccessToken: config.PersonalAccessTokenConfig{
+			DefaultExpiry: 90 * 24 * time.Hour,
+			MaxExpiry:     365 * 24 * time.Hour,
+		},
+	}
+
+	patService := NewPersonalAccessTokenService(mockUserRepo, mockPATRepo, mockAuditService, cfg)
+
+	// Test data
+	rawToken := PersonalAccessTokenPrefix + "secret"
+	// the token version moved on after a logout everywhere, password change or role change
+	user := &model.User{ID: primitive.NewObjectID(), TokenVersion: 2}
+	token := model.NewPersonalAccessToken(user.ID, 1, "ci", util.HashToken(rawToken), "mpat_secret", []string{"tasks:read"}, time.Hour)
+
+	// Mock expectations
+	mockPATRepo.EXPECT().
+		FindByHash(mock.Anything, util.HashToken(rawToken)).
+		Return(token, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	claims, err := patService.Authenticate(context.Background(), rawToken, "203.0.113.10")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrInvalidPersonalAccessToken)
+	assert.Nil(t, claims)
+}
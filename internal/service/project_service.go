@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/pkg/blobstore"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ProjectService interface {
+	Create(ctx context.Context, userID string, req dto.CreateProjectRequest) (*model.Project, error)
+	List(ctx context.Context, userID string) ([]model.Project, error)
+	GetByID(ctx context.Context, userID, id string) (*model.Project, error)
+	Update(ctx context.Context, userID, id string, req dto.UpdateProjectRequest) (*model.Project, error)
+	Delete(ctx context.Context, userID, id string) error
+	AddMember(ctx context.Context, userID, id string, req dto.AddProjectMemberRequest) (*model.Project, error)
+	UpdateMember(ctx context.Context, userID, id, memberID, role string) (*model.Project, error)
+	RemoveMember(ctx context.Context, userID, id, memberID string) (*model.Project, error)
+}
+
+type projectServiceImpl struct {
+	projectRepo  repository.ProjectRepository
+	taskRepo     repository.TaskRepository
+	commentRepo  repository.CommentRepository
+	userRepo     repository.UserRepository
+	blobStore    blobstore.BlobStore
+	auditService AuditService
+	config       *config.Config
+}
+
+func NewProjectService(projectRepo repository.ProjectRepository, taskRepo repository.TaskRepository, commentRepo repository.CommentRepository, userRepo repository.UserRepository, blobStore blobstore.BlobStore, auditService AuditService, config *config.Config) ProjectService {
+	return &projectServiceImpl{
+		projectRepo:  projectRepo,
+		taskRepo:     taskRepo,
+		commentRepo:  commentRepo,
+		userRepo:     userRepo,
+		blobStore:    blobStore,
+		auditService: auditService,
+		config:       config,
+	}
+}
+
+// findProjectForMember returns the project together with the user's role in
+// it. Projects the user is not a member of are reported as not found, so their
+// existence is not revealed.
+func findProjectForMember(ctx context.Context, projectRepo repository.ProjectRepository, id string, userID primitive.ObjectID) (*model.Project, model.ProjectRole, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, "", errors.New("invalid project ID")
+	}
+
+	project, err := projectRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if project == nil {
+		return nil, "", errors.New("project not found")
+	}
+
+	role, ok := project.MemberRole(userID)
+	if !ok {
+		return nil, "", errors.New("project not found")
+	}
+
+	return project, role, nil
+}
+
+func (s *projectServiceImpl) Create(ctx context.Context, userID string, req dto.CreateProjectRequest) (*model.Project, error) {
+	project, err := s.create(ctx, userID, req)
+
+	targetID := ""
+	if project != nil {
+		targetID = project.ID.Hex()
+	}
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionProjectCreate, userID, "project", targetID).WithError(err))
+
+	return project, err
+}
+
+func (s *projectServiceImpl) create(ctx context.Context, userID string, req dto.CreateProjectRequest) (*model.Project, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	project := model.NewProject(strings.TrimSpace(req.Name), req.Description, ownerID)
+
+	if err := s.projectRepo.Create(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (s *projectServiceImpl) List(ctx context.Context, userID string) ([]model.Project, error) {
+	memberID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	return s.projectRepo.FindByMember(ctx, memberID)
+}
+
+func (s *projectServiceImpl) GetByID(ctx context.Context, userID, id string) (*model.Project, error) {
+	memberID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	project, _, err := findProjectForMember(ctx, s.projectRepo, id, memberID)
+	return project, err
+}
+
+// findManagedProject is findProjectForMember for actions reserved to the
+// project's owners.
+func (s *projectServiceImpl) findManagedProject(ctx context.Context, userID, id string) (*model.Project, error) {
+	memberID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	project, role, err := findProjectForMember(ctx, s.projectRepo, id, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.CanManage() {
+		return nil, errors.New("insufficient project permissions")
+	}
+
+	return project, nil
+}
+
+func (s *projectServiceImpl) Update(ctx context.Context, userID, id string, req dto.UpdateProjectRequest) (*model.Project, error) {
+	project, err := s.update(ctx, userID, id, req)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionProjectUpdate, userID, "project", id).WithError(err))
+	return project, err
+}
+
+func (s *projectServiceImpl) update(ctx context.Context, userID, id string, req dto.UpdateProjectRequest) (*model.Project, error) {
+	project, err := s.findManagedProject(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		project.Name = strings.TrimSpace(*req.Name)
+	}
+
+	if req.Description != nil {
+		project.Description = *req.Description
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (s *projectServiceImpl) Delete(ctx context.Context, userID, id string) error {
+	err := s.delete(ctx, userID, id)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionProjectDelete, userID, "project", id).WithError(err).WithMetadata("mode", s.config.Project.DeleteMode))
+	return err
+}
+
+// delete handles the comments and tasks before the project, so a failure leaves the project
+// in place and the deletion can simply be retried. Attachment files go last,
+// once nothing refers to them anymore.
+func (s *projectServiceImpl) delete(ctx context.Context, userID, id string) error {
+	project, err := s.findManagedProject(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if s.config.Project.DeleteMode == "cascade" {
+		keys, err := s.taskRepo.FindStorageKeysByProject(ctx, project.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.commentRepo.DeleteByProject(ctx, project.ID); err != nil {
+			return err
+		}
+		if err := s.taskRepo.DeleteByProject(ctx, project.ID); err != nil {
+			return err
+		}
+		if err := s.projectRepo.Delete(ctx, project.ID); err != nil {
+			return err
+		}
+		deleteBlobs(ctx, s.blobStore, keys)
+		return nil
+	}
+
+	if err := s.taskRepo.ArchiveByProject(ctx, project.ID); err != nil {
+		return err
+	}
+	return s.projectRepo.Archive(ctx, project.ID)
+}
+
+func (s *projectServiceImpl) AddMember(ctx context.Context, userID, id string, req dto.AddProjectMemberRequest) (*model.Project, error) {
+	project, member, err := s.addMember(ctx, userID, id, req)
+
+	entry := model.NewAuditLog(model.AuditActionProjectMemberAdd, userID, "project", id).WithError(err).WithMetadata("role", req.Role)
+	if member != nil {
+		entry.WithMetadata("member_id", member.ID.Hex())
+	}
+	s.auditService.Record(ctx, entry)
+
+	return project, err
+}
+
+func (s *projectServiceImpl) addMember(ctx context.Context, userID, id string, req dto.AddProjectMemberRequest) (*model.Project, *model.User, error) {
+	if !model.IsValidProjectRole(req.Role) {
+		return nil, nil, errors.New("invalid project role")
+	}
+
+	project, err := s.findManagedProject(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user == nil {
+		return nil, nil, errors.New("user not found")
+	}
+
+	if _, ok := project.MemberRole(user.ID); ok {
+		return nil, user, errors.New("user is already a member of the project")
+	}
+
+	project, err = s.projectRepo.AddMember(ctx, project.ID, model.ProjectMember{
+		UserID:  user.ID,
+		Role:    model.ProjectRole(req.Role),
+		AddedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, user, err
+	}
+
+	// the user was added by a concurrent request since the project was read
+	if project == nil {
+		return nil, user, errors.New("user is already a member of the project")
+	}
+
+	return project, user, nil
+}
+
+func (s *projectServiceImpl) UpdateMember(ctx context.Context, userID, id, memberID, role string) (*model.Project, error) {
+	project, err := s.updateMember(ctx, userID, id, memberID, role)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionProjectMemberUpdate, userID, "project", id).WithError(err).WithMetadata("member_id", memberID).WithMetadata("role", role))
+	return project, err
+}
+
+func (s *projectServiceImpl) updateMember(ctx context.Context, userID, id, memberID, role string) (*model.Project, error) {
+	if !model.IsValidProjectRole(role) {
+		return nil, errors.New("invalid project role")
+	}
+
+	memberObjectID, err := primitive.ObjectIDFromHex(memberID)
+	if err != nil {
+		return nil, errors.New("invalid member ID")
+	}
+
+	project, err := s.findManagedProject(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	index := memberIndex(project, memberObjectID)
+	if index < 0 {
+		return nil, errors.New("member not found")
+	}
+
+	if project.Members[index].Role == model.ProjectRoleOwner && model.ProjectRole(role) != model.ProjectRoleOwner && project.OwnerCount() == 1 {
+		return nil, errors.New("project must keep at least one owner")
+	}
+
+	updated, err := s.projectRepo.UpdateMemberRole(ctx, project.ID, memberObjectID, model.ProjectRole(role))
+	if err != nil {
+		return nil, err
+	}
+
+	// the members changed since the project was read
+	if updated == nil {
+		return nil, s.memberChangeError(ctx, project.ID, memberObjectID)
+	}
+
+	return updated, nil
+}
+
+func (s *projectServiceImpl) RemoveMember(ctx context.Context, userID, id, memberID string) (*model.Project, error) {
+	project, err := s.removeMember(ctx, userID, id, memberID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionProjectMemberRemove, userID, "project", id).WithError(err).WithMetadata("member_id", memberID))
+	return project, err
+}
+
+// removeMember lets owners remove anyone and every member leave on their own.
+// The member is unassigned from the project's tasks first, so assignees of
+// project tasks are always members.
+func (s *projectServiceImpl) removeMember(ctx context.Context, userID, id, memberID string) (*model.Project, error) {
+	actorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	memberObjectID, err := primitive.ObjectIDFromHex(memberID)
+	if err != nil {
+		return nil, errors.New("invalid member ID")
+	}
+
+	project, role, err := findProjectForMember(ctx, s.projectRepo, id, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorID != memberObjectID && !role.CanManage() {
+		return nil, errors.New("insufficient project permissions")
+	}
+
+	index := memberIndex(project, memberObjectID)
+	if index < 0 {
+		return nil, errors.New("member not found")
+	}
+
+	if project.Members[index].Role == model.ProjectRoleOwner && project.OwnerCount() == 1 {
+		return nil, errors.New("project must keep at least one owner")
+	}
+
+	if err := s.taskRepo.RemoveAssigneeFromProject(ctx, project.ID, memberObjectID); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.projectRepo.RemoveMember(ctx, project.ID, memberObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the members changed since the project was read
+	if updated == nil {
+		return nil, s.memberChangeError(ctx, project.ID, memberObjectID)
+	}
+
+	return updated, nil
+}
+
+// memberChangeError explains why a member change guarded against concurrent
+// changes was rejected, by reading the members as they are now.
+func (s *projectServiceImpl) memberChangeError(ctx context.Context, id, memberID primitive.ObjectID) error {
+	project, err := s.projectRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if project == nil {
+		return errors.New("project not found")
+	}
+
+	if memberIndex(project, memberID) < 0 {
+		return errors.New("member not found")
+	}
+
+	return errors.New("project must keep at least one owner")
+}
+
+func memberIndex(project *model.Project, userID primitive.ObjectID) int {
+	for i, member := range project.Members {
+		if member.UserID == userID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newTestProject(ownerID primitive.ObjectID) *model.Project {
+	project := model.NewProject("Test Project", "Test Description", ownerID)
+	project.ID = primitive.NewObjectID()
+	return project
+}
+
+func TestProjectService_Create_Success(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	req := dto.CreateProjectRequest{
+		Name:        "  Test Project  ",
+		Description: "Test Description",
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		Create(mock.Anything, mock.AnythingOfType("*model.Project")).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectCreate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	project, err := projectService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, project)
+	assert.Equal(t, "Test Project", project.Name)
+	assert.Len(t, project.Members, 1)
+	assert.Equal(t, userID, project.Members[0].UserID)
+	assert.Equal(t, model.ProjectRoleOwner, project.Members[0].Role)
+}
+
+func TestProjectService_GetByID_NotMember(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	project := newTestProject(primitive.NewObjectID())
+	outsiderID := primitive.NewObjectID()
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	// Execute
+	result, err := projectService.GetByID(context.Background(), outsiderID.Hex(), project.ID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "project not found", err.Error())
+}
+
+func TestProjectService_Update_ViewerForbidden(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	project := newTestProject(primitive.NewObjectID())
+	viewerID := primitive.NewObjectID()
+	project.Members = append(project.Members, model.ProjectMember{UserID: viewerID, Role: model.ProjectRoleViewer, AddedAt: time.Now()})
+	name := "Renamed"
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectUpdate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.Update(context.Background(), viewerID.Hex(), project.ID.Hex(), dto.UpdateProjectRequest{Name: &name})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "insufficient project permissions", err.Error())
+}
+
+func TestProjectService_Delete_Archive(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		ArchiveByProject(mock.Anything, project.ID).
+		Return(nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		Archive(mock.Anything, project.ID).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectDelete && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := projectService.Delete(context.Background(), ownerID.Hex(), project.ID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestProjectService_Delete_Cascade(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "cascade",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+	storageKey := "tasks/" + primitive.NewObjectID().Hex() + "/" + primitive.NewObjectID().Hex()
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		FindStorageKeysByProject(mock.Anything, project.ID).
+		Return([]string{storageKey}, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		DeleteByProject(mock.Anything, project.ID).
+		Return(nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		DeleteByProject(mock.Anything, project.ID).
+		Return(nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		Delete(mock.Anything, project.ID).
+		Return(nil).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Delete(mock.Anything, storageKey).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectDelete && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := projectService.Delete(context.Background(), ownerID.Hex(), project.ID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestProjectService_AddMember_Success(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "editor@example.com",
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, user.Email).
+		Return(user, nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		AddMember(mock.Anything, project.ID, mock.MatchedBy(func(member model.ProjectMember) bool {
+			return member.UserID == user.ID && member.Role == model.ProjectRoleEditor
+		})).
+		RunAndReturn(func(_ context.Context, _ primitive.ObjectID, member model.ProjectMember) (*model.Project, error) {
+			updated := *project
+			updated.Members = append(updated.Members, member)
+			return &updated, nil
+		}).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectMemberAdd && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.AddMember(context.Background(), ownerID.Hex(), project.ID.Hex(), dto.AddProjectMemberRequest{
+		Email: user.Email,
+		Role:  "editor",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Members, 2)
+}
+
+func TestProjectService_AddMember_AlreadyMember(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+	owner := &model.User{
+		ID:    ownerID,
+		Email: "owner@example.com",
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByEmail(mock.Anything, owner.Email).
+		Return(owner, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectMemberAdd && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.AddMember(context.Background(), ownerID.Hex(), project.ID.Hex(), dto.AddProjectMemberRequest{
+		Email: owner.Email,
+		Role:  "viewer",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "user is already a member of the project", err.Error())
+}
+
+func TestProjectService_RemoveMember_LastOwner(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectMemberRemove && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.RemoveMember(context.Background(), ownerID.Hex(), project.ID.Hex(), ownerID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "project must keep at least one owner", err.Error())
+}
+
+func TestProjectService_RemoveMember_SelfLeave(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	project := newTestProject(primitive.NewObjectID())
+	viewerID := primitive.NewObjectID()
+	project.Members = append(project.Members, model.ProjectMember{UserID: viewerID, Role: model.ProjectRoleViewer, AddedAt: time.Now()})
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RemoveAssigneeFromProject(mock.Anything, project.ID, viewerID).
+		Return(nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		RemoveMember(mock.Anything, project.ID, viewerID).
+		Return(newTestProject(project.Members[0].UserID), nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectMemberRemove && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.RemoveMember(context.Background(), viewerID.Hex(), project.ID.Hex(), viewerID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Members, 1)
+}
+
+func TestProjectService_RemoveMember_ConcurrentOwnerRemoval(t *testing.T) {
+	// Setup
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	cfg := &config.Config{
+		Project: config.ProjectConfig{
+			DeleteMode: "archive",
+		},
+	}
+
+	projectService := NewProjectService(mockProjectRepo, mockTaskRepo, mockCommentRepo, mockUserRepo, mockBlobStore, mockAuditService, cfg)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	otherOwnerID := primitive.NewObjectID()
+	project := newTestProject(ownerID)
+	project.Members = append(project.Members, model.ProjectMember{UserID: otherOwnerID, Role: model.ProjectRoleOwner, AddedAt: time.Now()})
+	// the other owner left after the project was read
+	current := newTestProject(ownerID)
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		RemoveAssigneeFromProject(mock.Anything, project.ID, ownerID).
+		Return(nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		RemoveMember(mock.Anything, project.ID, ownerID).
+		Return(nil, nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(current, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionProjectMemberRemove && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	result, err := projectService.RemoveMember(context.Background(), ownerID.Hex(), project.ID.Hex(), ownerID.Hex())
+
+	// Assert
+	assert.EqualError(t, err, "project must keep at least one owner")
+	assert.Nil(t, result)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/pkg/cache"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	maxUserAgentLength = 512
+	// last-seen bookkeeping is skipped when the session was seen this recently
+	// from the same IP, so an active client doesn't cause a write per request
+	sessionTouchInterval = time.Minute
+)
+
+type SessionService interface {
+	List(ctx context.Context, userID string) ([]model.Session, error)
+	Revoke(ctx context.Context, userID, sessionID string) error
+	Touch(ctx context.Context, claims *util.JWTClaims, ip string) error
+}
+
+type sessionServiceImpl struct {
+	sessionRepo  repository.SessionRepository
+	tokenService TokenService
+	auditService AuditService
+	touchCache   *cache.TTLCache[string, string]
+}
+
+func NewSessionService(sessionRepo repository.SessionRepository, tokenService TokenService, auditService AuditService) SessionService {
+	return &sessionServiceImpl{
+		sessionRepo:  sessionRepo,
+		tokenService: tokenService,
+		auditService: auditService,
+		touchCache:   cache.NewTTLCache[string, string](tokenCacheSize),
+	}
+}
+
+func (s *sessionServiceImpl) List(ctx context.Context, userID string) ([]model.Session, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	return s.sessionRepo.FindActiveByUser(ctx, ownerID)
+}
+
+func (s *sessionServiceImpl) Revoke(ctx context.Context, userID, sessionID string) error {
+	err := s.tokenService.RevokeSession(ctx, userID, sessionID)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionSessionRevoke, userID, "session", sessionID).WithError(err))
+	return err
+}
+
+// Touch records that the session behind claims is in use.
+func (s *sessionServiceImpl) Touch(ctx context.Context, claims *util.JWTClaims, ip string) error {
+	if claims.SessionID == "" {
+		return nil
+	}
+
+	if lastIP, ok := s.touchCache.Get(claims.SessionID); ok && lastIP == ip {
+		return nil
+	}
+
+	sessionID, err := primitive.ObjectIDFromHex(claims.SessionID)
+	if err != nil {
+		return errors.New("invalid session ID")
+	}
+
+	// a zero expiry leaves the session's expiry alone; only refreshing extends it
+	if err := s.sessionRepo.Touch(ctx, sessionID, ip, time.Now(), time.Time{}); err != nil {
+		return err
+	}
+
+	s.touchCache.Set(claims.SessionID, ip, sessionTouchInterval)
+	return nil
+}
+
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+	return userAgent
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSessionService_List(t *testing.T) {
+	// Setup
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	sessionService := NewSessionService(mockSessionRepo, mockTokenService, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	sessions := []model.Session{
+		*model.NewSession(userID, "family-1", "test-agent", "203.0.113.10", time.Hour),
+	}
+
+	// Mock expectations
+	mockSessionRepo.EXPECT().
+		FindActiveByUser(mock.Anything, userID).
+		Return(sessions, nil).
+		Once()
+
+	// Execute
+	result, err := sessionService.List(context.Background(), userID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, sessions, result)
+}
+
+func TestSessionService_Revoke(t *testing.T) {
+	// Setup
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	sessionService := NewSessionService(mockSessionRepo, mockTokenService, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID().Hex()
+	sessionID := primitive.NewObjectID().Hex()
+
+	// Mock expectations
+	mockTokenService.EXPECT().
+		RevokeSession(mock.Anything, userID, sessionID).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionSessionRevoke && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	// Execute
+	err := sessionService.Revoke(context.Background(), userID, sessionID)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestSessionService_Touch_Throttled(t *testing.T) {
+	// Setup
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	sessionService := NewSessionService(mockSessionRepo, mockTokenService, mockAuditService)
+
+	// Test data
+	sessionID := primitive.NewObjectID()
+	claims := &util.JWTClaims{UserID: primitive.NewObjectID().Hex(), SessionID: sessionID.Hex()}
+
+	// Mock expectations: the repeated touch from the same IP is skipped, a new IP is recorded
+	mockSessionRepo.EXPECT().
+		Touch(mock.Anything, sessionID, "203.0.113.10", mock.AnythingOfType("time.Time"), time.Time{}).
+		Return(nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		Touch(mock.Anything, sessionID, "198.51.100.7", mock.AnythingOfType("time.Time"), time.Time{}).
+		Return(nil).
+		Once()
+
+	// Execute
+	err1 := sessionService.Touch(context.Background(), claims, "203.0.113.10")
+	err2 := sessionService.Touch(context.Background(), claims, "203.0.113.10")
+	err3 := sessionService.Touch(context.Background(), claims, "198.51.100.7")
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.NoError(t, err3)
+}
+
+func TestSessionService_Touch_WithoutSession(t *testing.T) {
+	// Setup
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+
+	sessionService := NewSessionService(mockSessionRepo, mockTokenService, mockAuditService)
+
+	// Test data
+	claims := &util.JWTClaims{UserID: primitive.NewObjectID().Hex()}
+
+	// Execute
+	err := sessionService.Touch(context.Background(), claims, "203.0.113.10")
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -4,33 +4,90 @@ import (
 	"context"
 	"errors"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/grachmannico95/mileapp-test-be/internal/dto"
 	"github.com/grachmannico95/mileapp-test-be/internal/model"
 	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/pkg/blobstore"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type TaskService interface {
-	Create(ctx context.Context, req dto.CreateTaskRequest) (*model.Task, error)
-	GetByID(ctx context.Context, id string) (*model.Task, error)
-	List(ctx context.Context, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)
-	Update(ctx context.Context, id string, req dto.UpdateTaskRequest) (*model.Task, error)
-	Delete(ctx context.Context, id string) error
+	Create(ctx context.Context, userID string, req dto.CreateTaskRequest) (*model.Task, error)
+	GetByID(ctx context.Context, userID, id string) (*model.Task, error)
+	List(ctx context.Context, userID string, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)
+	Update(ctx context.Context, userID, id string, req dto.UpdateTaskRequest) (*model.Task, error)
+	Delete(ctx context.Context, userID, id string) error
 }
 
 type taskServiceImpl struct {
-	taskRepo repository.TaskRepository
+	taskRepo     repository.TaskRepository
+	projectRepo  repository.ProjectRepository
+	userRepo     repository.UserRepository
+	labelRepo    repository.LabelRepository
+	commentRepo  repository.CommentRepository
+	blobStore    blobstore.BlobStore
+	auditService AuditService
 }
 
-func NewTaskService(taskRepo repository.TaskRepository) TaskService {
+func NewTaskService(taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository, userRepo repository.UserRepository, labelRepo repository.LabelRepository, commentRepo repository.CommentRepository, blobStore blobstore.BlobStore, auditService AuditService) TaskService {
 	return &taskServiceImpl{
-		taskRepo: taskRepo,
+		taskRepo:     taskRepo,
+		projectRepo:  projectRepo,
+		userRepo:     userRepo,
+		labelRepo:    labelRepo,
+		commentRepo:  commentRepo,
+		blobStore:    blobStore,
+		auditService: auditService,
 	}
 }
 
-func (s *taskServiceImpl) Create(ctx context.Context, req dto.CreateTaskRequest) (*model.Task, error) {
+func (s *taskServiceImpl) Create(ctx context.Context, userID string, req dto.CreateTaskRequest) (*model.Task, error) {
+	task, err := s.create(ctx, userID, req)
+
+	targetID := ""
+	if task != nil {
+		targetID = task.ID.Hex()
+	}
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionTaskCreate, userID, "task", targetID).WithError(err))
+
+	return task, err
+}
+
+func (s *taskServiceImpl) create(ctx context.Context, userID string, req dto.CreateTaskRequest) (*model.Task, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	var project *model.Project
+	var projectID *primitive.ObjectID
+	if req.ProjectID != "" {
+		var role model.ProjectRole
+		project, role, err = findProjectForMember(ctx, s.projectRepo, req.ProjectID, ownerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !role.CanWriteTasks() {
+			return nil, errors.New("insufficient project permissions")
+		}
+
+		projectID = &project.ID
+	}
+
+	assigneeIDs, err := s.validateAssignees(ctx, ownerID, req.AssigneeIDs, project)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.validateTags(ctx, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	status := model.TaskStatusPending
 	if req.Status != "" {
 		status = model.TaskStatus(req.Status)
@@ -49,34 +106,338 @@ func (s *taskServiceImpl) Create(ctx context.Context, req dto.CreateTaskRequest)
 		dueDate = &req.DueDate.Time
 	}
 
-	task := model.NewTask(req.Title, req.Description, status, priority, dueDate)
+	task := model.NewTask(ownerID, projectID, assigneeIDs, tags, req.Title, req.Description, status, priority, dueDate)
 
 	if err := s.taskRepo.Create(ctx, task); err != nil {
 		return nil, err
 	}
 
+	if err := s.loadDetails(ctx, ownerID, []*model.Task{task}); err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
-func (s *taskServiceImpl) GetByID(ctx context.Context, id string) (*model.Task, error) {
-	objectID, err := primitive.ObjectIDFromHex(id)
+// validateAssignees parses and de-duplicates the assignee IDs and checks that
+// every assignee exists. Assignees of a project task must be project members,
+// personal tasks may only be assigned to their creator.
+func (s *taskServiceImpl) validateAssignees(ctx context.Context, creatorID primitive.ObjectID, ids []string, project *model.Project) ([]primitive.ObjectID, error) {
+	assigneeIDs := []primitive.ObjectID{}
+	seen := make(map[primitive.ObjectID]bool)
+
+	for _, id := range ids {
+		assigneeID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.New("invalid assignee ID")
+		}
+
+		if seen[assigneeID] {
+			continue
+		}
+		seen[assigneeID] = true
+
+		// checked before the lookup so personal tasks cannot be used to probe
+		// which accounts exist
+		if project == nil && assigneeID != creatorID {
+			return nil, errors.New("personal tasks can only be assigned to their creator")
+		}
+
+		user, err := s.userRepo.FindByID(ctx, assigneeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if user == nil {
+			return nil, errors.New("assignee not found")
+		}
+
+		if project != nil {
+			if _, ok := project.MemberRole(assigneeID); !ok {
+				return nil, errors.New("assignee is not a project member")
+			}
+		}
+
+		assigneeIDs = append(assigneeIDs, assigneeID)
+	}
+
+	return assigneeIDs, nil
+}
+
+// validateTags trims and de-duplicates the tags and checks that each one names
+// a label from the catalogue.
+func (s *taskServiceImpl) validateTags(ctx context.Context, names []string) ([]string, error) {
+	tags := normalizeTags(names)
+	if len(tags) == 0 {
+		return tags, nil
+	}
+
+	labels, err := s.labelRepo.FindByNames(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(labels) != len(tags) {
+		return nil, errors.New("label not found")
+	}
+
+	return tags, nil
+}
+
+func normalizeTags(names []string) []string {
+	tags := []string{}
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+
+	return tags
+}
+
+// loadDetails fills in the fields of the tasks that are not stored with them,
+// as seen by the user.
+func (s *taskServiceImpl) loadDetails(ctx context.Context, userID primitive.ObjectID, tasks []*model.Task) error {
+	if err := s.loadAssignees(ctx, userID, tasks); err != nil {
+		return err
+	}
+
+	return s.loadCommentCounts(ctx, tasks)
+}
+
+func (s *taskServiceImpl) loadCommentCounts(ctx context.Context, tasks []*model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+
+	counts, err := s.commentRepo.CountByTasks(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		task.CommentCount = counts[task.ID]
+	}
+
+	return nil
+}
+
+// loadAssignees fills in the assignee profiles of the tasks with a single
+// lookup. Assignees whose account no longer exists are left out. The email of
+// an assignee is only shown to the assignee and to users sharing a project
+// with them.
+func (s *taskServiceImpl) loadAssignees(ctx context.Context, userID primitive.ObjectID, tasks []*model.Task) error {
+	ids := []primitive.ObjectID{}
+	for _, task := range tasks {
+		ids = append(ids, task.AssigneeIDs...)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	coMembers, err := s.findCoMembers(ctx, userID, users)
+	if err != nil {
+		return err
+	}
+
+	profiles := make(map[primitive.ObjectID]model.TaskAssignee, len(users))
+	for _, user := range users {
+		profile := model.TaskAssignee{
+			ID:          user.ID,
+			DisplayName: user.DisplayName,
+		}
+		if user.ID == userID || coMembers[user.ID] {
+			profile.Email = user.Email
+		}
+		profiles[user.ID] = profile
+	}
+
+	for _, task := range tasks {
+		task.Assignees = []model.TaskAssignee{}
+		for _, assigneeID := range task.AssigneeIDs {
+			if profile, ok := profiles[assigneeID]; ok {
+				task.Assignees = append(task.Assignees, profile)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findCoMembers returns which of the users share a project with the user. The
+// projects are only looked up when somebody else is among the users.
+func (s *taskServiceImpl) findCoMembers(ctx context.Context, userID primitive.ObjectID, users []model.User) (map[primitive.ObjectID]bool, error) {
+	coMembers := make(map[primitive.ObjectID]bool)
+
+	others := false
+	for _, user := range users {
+		if user.ID != userID {
+			others = true
+			break
+		}
+	}
+
+	if !others {
+		return coMembers, nil
+	}
+
+	projects, err := s.projectRepo.FindByMember(ctx, userID)
 	if err != nil {
-		return nil, errors.New("invalid task ID")
+		return nil, err
+	}
+
+	for _, project := range projects {
+		for _, member := range project.Members {
+			coMembers[member.UserID] = true
+		}
 	}
 
-	task, err := s.taskRepo.FindByID(ctx, objectID)
+	return coMembers, nil
+}
+
+func (s *taskServiceImpl) GetByID(ctx context.Context, userID, id string) (*model.Task, error) {
+	viewerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, id, false)
 	if err != nil {
 		return nil, err
 	}
 
-	if task == nil {
-		return nil, errors.New("task not found")
+	if err := s.loadDetails(ctx, viewerID, []*model.Task{task}); err != nil {
+		return nil, err
 	}
 
 	return task, nil
 }
 
-func (s *taskServiceImpl) List(ctx context.Context, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error) {
+// findTaskForUser returns the task, and its project for project tasks, when the
+// user may access it. Personal tasks are visible to their creator and
+// assignees but only the creator may change them, project tasks are visible to
+// the project's members; all other tasks are reported as not found. With write
+// set the user's project role must also allow changing tasks.
+func findTaskForUser(ctx context.Context, taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository, userID, id string, write bool) (*model.Task, *model.Project, error) {
+	memberID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, nil, errors.New("invalid user ID")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil, errors.New("invalid task ID")
+	}
+
+	task, err := taskRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if task == nil {
+		return nil, nil, errors.New("task not found")
+	}
+
+	if task.ProjectID == nil {
+		if task.UserID == memberID {
+			return task, nil, nil
+		}
+
+		if !task.IsAssignee(memberID) {
+			return nil, nil, errors.New("task not found")
+		}
+
+		if write {
+			return nil, nil, errors.New("only the task creator can change this task")
+		}
+		return task, nil, nil
+	}
+
+	project, err := projectRepo.FindByID(ctx, *task.ProjectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if project == nil {
+		return nil, nil, errors.New("task not found")
+	}
+
+	role, ok := project.MemberRole(memberID)
+	if !ok {
+		return nil, nil, errors.New("task not found")
+	}
+
+	if write && !role.CanWriteTasks() {
+		return nil, nil, errors.New("insufficient project permissions")
+	}
+
+	return task, project, nil
+}
+
+func (s *taskServiceImpl) List(ctx context.Context, userID string, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error) {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, dto.PaginationMeta{}, errors.New("invalid user ID")
+	}
+
+	var assigneeID primitive.ObjectID
+	if params.Assignee != "" {
+		if params.AssignedToMe {
+			return nil, dto.PaginationMeta{}, errors.New("assignee and assigned_to_me cannot be combined")
+		}
+
+		assigneeID, err = primitive.ObjectIDFromHex(params.Assignee)
+		if err != nil {
+			return nil, dto.PaginationMeta{}, errors.New("invalid assignee ID")
+		}
+	}
+
+	if params.AssignedToMe {
+		assigneeID = ownerID
+	}
+
+	// assignees of project tasks are always project members, so the tasks
+	// assigned to the caller need no further scoping
+	filters := repository.TaskFilters{UserID: ownerID, AssigneeID: assigneeID}
+	if params.ProjectID != "" {
+		project, _, err := findProjectForMember(ctx, s.projectRepo, params.ProjectID, ownerID)
+		if err != nil {
+			return nil, dto.PaginationMeta{}, err
+		}
+
+		filters = repository.TaskFilters{ProjectID: project.ID, AssigneeID: assigneeID}
+	} else if params.AssignedToMe {
+		filters = repository.TaskFilters{AssigneeID: assigneeID}
+	} else {
+		// tasks the caller created in projects they have since left stay with
+		// the project, so they must not show up in the personal list
+		projects, err := s.projectRepo.FindByMember(ctx, ownerID)
+		if err != nil {
+			return nil, dto.PaginationMeta{}, err
+		}
+
+		filters.MemberProjectIDs = make([]primitive.ObjectID, 0, len(projects))
+		for _, project := range projects {
+			filters.MemberProjectIDs = append(filters.MemberProjectIDs, project.ID)
+		}
+	}
+
 	if params.Page < 1 {
 		params.Page = 1
 	}
@@ -93,23 +454,35 @@ func (s *taskServiceImpl) List(ctx context.Context, params dto.TaskQueryParams)
 		params.SortOrder = "desc"
 	}
 
-	filters := repository.TaskFilters{
-		Status:      params.Status,
-		Priority:    params.Priority,
-		Search:      params.Search,
-		DueDateFrom: params.DueDateFrom,
-		DueDateTo:   params.DueDateTo,
-		SortBy:      params.SortBy,
-		SortOrder:   params.SortOrder,
-		Page:        params.Page,
-		Limit:       params.Limit,
+	if params.Tags != "" {
+		filters.Tags = normalizeTags(strings.Split(params.Tags, ","))
+		filters.TagsMatch = params.TagsMatch
 	}
 
+	filters.Status = params.Status
+	filters.Priority = params.Priority
+	filters.Search = params.Search
+	filters.DueDateFrom = params.DueDateFrom
+	filters.DueDateTo = params.DueDateTo
+	filters.SortBy = params.SortBy
+	filters.SortOrder = params.SortOrder
+	filters.Page = params.Page
+	filters.Limit = params.Limit
+
 	tasks, total, err := s.taskRepo.Find(ctx, filters)
 	if err != nil {
 		return nil, dto.PaginationMeta{}, err
 	}
 
+	taskRefs := make([]*model.Task, len(tasks))
+	for i := range tasks {
+		taskRefs[i] = &tasks[i]
+	}
+
+	if err := s.loadDetails(ctx, ownerID, taskRefs); err != nil {
+		return nil, dto.PaginationMeta{}, err
+	}
+
 	totalPages := int(math.Ceil(float64(total) / float64(params.Limit)))
 
 	meta := dto.PaginationMeta{
@@ -122,12 +495,39 @@ func (s *taskServiceImpl) List(ctx context.Context, params dto.TaskQueryParams)
 	return tasks, meta, nil
 }
 
-func (s *taskServiceImpl) Update(ctx context.Context, id string, req dto.UpdateTaskRequest) (*model.Task, error) {
-	task, err := s.GetByID(ctx, id)
+func (s *taskServiceImpl) Update(ctx context.Context, userID, id string, req dto.UpdateTaskRequest) (*model.Task, error) {
+	task, err := s.update(ctx, userID, id, req)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionTaskUpdate, userID, "task", id).WithError(err))
+	return task, err
+}
+
+func (s *taskServiceImpl) update(ctx context.Context, userID, id string, req dto.UpdateTaskRequest) (*model.Task, error) {
+	editorID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	task, project, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, id, true)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.AssigneeIDs != nil {
+		assigneeIDs, err := s.validateAssignees(ctx, task.UserID, *req.AssigneeIDs, project)
+		if err != nil {
+			return nil, err
+		}
+		task.AssigneeIDs = assigneeIDs
+	}
+
+	if req.Tags != nil {
+		tags, err := s.validateTags(ctx, *req.Tags)
+		if err != nil {
+			return nil, err
+		}
+		task.Tags = tags
+	}
+
 	if req.Title != "" {
 		task.Title = req.Title
 	}
@@ -157,14 +557,36 @@ func (s *taskServiceImpl) Update(ctx context.Context, id string, req dto.UpdateT
 		return nil, err
 	}
 
+	if err := s.loadDetails(ctx, editorID, []*model.Task{task}); err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
-func (s *taskServiceImpl) Delete(ctx context.Context, id string) error {
-	objectID, err := primitive.ObjectIDFromHex(id)
+func (s *taskServiceImpl) Delete(ctx context.Context, userID, id string) error {
+	err := s.delete(ctx, userID, id)
+	s.auditService.Record(ctx, model.NewAuditLog(model.AuditActionTaskDelete, userID, "task", id).WithError(err))
+	return err
+}
+
+func (s *taskServiceImpl) delete(ctx context.Context, userID, id string) error {
+	task, _, err := findTaskForUser(ctx, s.taskRepo, s.projectRepo, userID, id, true)
 	if err != nil {
-		return errors.New("invalid task ID")
+		return err
+	}
+
+	if err := s.taskRepo.Delete(ctx, task.UserID, task.ID); err != nil {
+		return err
+	}
+
+	keys := make([]string, len(task.Attachments))
+	for i, attachment := range task.Attachments {
+		keys[i] = attachment.StorageKey
 	}
+	deleteBlobs(ctx, s.blobStore, keys)
 
-	return s.taskRepo.Delete(ctx, objectID)
+	// comments of a task that is gone can no longer be reached, so a failure
+	// here only leaves unreachable documents behind
+	return s.commentRepo.DeleteByTask(ctx, task.ID)
 }
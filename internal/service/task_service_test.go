@@ -2,7 +2,6 @@ package service
 
 import (
 	"context"
-	"errors"
 	"testing"
 	"time"
 
@@ -18,9 +17,16 @@ import (
 func TestTaskService_Create_Success(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	futureDate := time.Now().Add(24 * time.Hour)
 	req := dto.CreateTaskRequest{
 		Title:       "Test Task",
@@ -33,7 +39,8 @@ func TestTaskService_Create_Success(t *testing.T) {
 	// Mock expectations
 	mockTaskRepo.EXPECT().
 		Create(mock.Anything, mock.MatchedBy(func(task *model.Task) bool {
-			return task.Title == req.Title &&
+			return task.UserID == userID &&
+				task.Title == req.Title &&
 				task.Description == req.Description &&
 				task.Status == model.TaskStatusPending &&
 				task.Priority == 3
@@ -41,22 +48,75 @@ func TestTaskService_Create_Success(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
 	// Execute
-	task, err := taskService.Create(context.Background(), req)
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, task)
+	assert.Equal(t, userID, task.UserID)
 	assert.Equal(t, req.Title, task.Title)
 	assert.Equal(t, req.Description, task.Description)
 }
 
+func TestTaskService_Create_InvalidUserID(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	req := dto.CreateTaskRequest{
+		Title: "Test Task",
+	}
+
+	// Mock expectations
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute with invalid user ID
+	task, err := taskService.Create(context.Background(), "invalid-id", req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "invalid user ID", err.Error())
+}
+
 func TestTaskService_Create_PastDueDate(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data with past due date
+	userID := primitive.NewObjectID()
 	pastDate := time.Now().Add(-24 * time.Hour)
 	req := dto.CreateTaskRequest{
 		Title:       "Test Task",
@@ -64,8 +124,16 @@ func TestTaskService_Create_PastDueDate(t *testing.T) {
 		DueDate:     &dto.JSONTime{Time: pastDate},
 	}
 
+	// Mock expectations
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
 	// Execute
-	task, err := taskService.Create(context.Background(), req)
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
 
 	// Assert
 	assert.Error(t, err)
@@ -76,12 +144,20 @@ func TestTaskService_Create_PastDueDate(t *testing.T) {
 func TestTaskService_GetByID_Success(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	taskID := primitive.NewObjectID()
 	expectedTask := &model.Task{
 		ID:          taskID,
+		UserID:      userID,
 		Title:       "Test Task",
 		Description: "Test Description",
 		Status:      model.TaskStatusPending,
@@ -96,23 +172,38 @@ func TestTaskService_GetByID_Success(t *testing.T) {
 		Return(expectedTask, nil).
 		Once()
 
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{taskID: 3}, nil).
+		Once()
+
 	// Execute
-	task, err := taskService.GetByID(context.Background(), taskID.Hex())
+	task, err := taskService.GetByID(context.Background(), userID.Hex(), taskID.Hex())
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, task)
 	assert.Equal(t, expectedTask.ID, task.ID)
 	assert.Equal(t, expectedTask.Title, task.Title)
+	assert.Equal(t, int64(3), task.CommentCount)
 }
 
 func TestTaskService_GetByID_InvalidID(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
 
 	// Execute with invalid ID
-	task, err := taskService.GetByID(context.Background(), "invalid-id")
+	task, err := taskService.GetByID(context.Background(), userID.Hex(), "invalid-id")
 
 	// Assert
 	assert.Error(t, err)
@@ -123,9 +214,16 @@ func TestTaskService_GetByID_InvalidID(t *testing.T) {
 func TestTaskService_GetByID_NotFound(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	taskID := primitive.NewObjectID()
 
 	// Mock expectations
@@ -135,7 +233,45 @@ func TestTaskService_GetByID_NotFound(t *testing.T) {
 		Once()
 
 	// Execute
-	task, err := taskService.GetByID(context.Background(), taskID.Hex())
+	task, err := taskService.GetByID(context.Background(), userID.Hex(), taskID.Hex())
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "task not found", err.Error())
+}
+
+func TestTaskService_GetByID_OtherOwner(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	otherUserID := primitive.NewObjectID()
+	taskID := primitive.NewObjectID()
+	existingTask := &model.Task{
+		ID:     taskID,
+		UserID: ownerID,
+		Title:  "Test Task",
+		Status: model.TaskStatusPending,
+	}
+
+	// Mock expectations: another user's personal task is indistinguishable
+	// from a missing one
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(existingTask, nil).
+		Once()
+
+	// Execute
+	task, err := taskService.GetByID(context.Background(), otherUserID.Hex(), taskID.Hex())
 
 	// Assert
 	assert.Error(t, err)
@@ -146,9 +282,16 @@ func TestTaskService_GetByID_NotFound(t *testing.T) {
 func TestTaskService_List_Success(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	params := dto.TaskQueryParams{
 		Page:      1,
 		Limit:     10,
@@ -179,17 +322,28 @@ func TestTaskService_List_Success(t *testing.T) {
 	}
 
 	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByMember(mock.Anything, userID).
+		Return([]model.Project{}, nil).
+		Once()
+
 	mockTaskRepo.EXPECT().
 		Find(mock.Anything, mock.MatchedBy(func(filters repository.TaskFilters) bool {
-			return filters.Page == 1 &&
+			return filters.UserID == userID &&
+				filters.Page == 1 &&
 				filters.Limit == 10 &&
 				filters.Status == "pending"
 		})).
 		Return(expectedTasks, int64(2), nil).
 		Once()
 
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
 	// Execute
-	tasks, meta, err := taskService.List(context.Background(), params)
+	tasks, meta, err := taskService.List(context.Background(), userID.Hex(), params)
 
 	// Assert
 	assert.NoError(t, err)
@@ -203,12 +357,20 @@ func TestTaskService_List_Success(t *testing.T) {
 func TestTaskService_Update_Success(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	taskID := primitive.NewObjectID()
 	existingTask := &model.Task{
 		ID:          taskID,
+		UserID:      userID,
 		Title:       "Old Title",
 		Description: "Old Description",
 		Status:      model.TaskStatusPending,
@@ -238,8 +400,20 @@ func TestTaskService_Update_Success(t *testing.T) {
 		Return(nil).
 		Once()
 
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskUpdate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
 	// Execute
-	updatedTask, err := taskService.Update(context.Background(), taskID.Hex(), updateReq)
+	updatedTask, err := taskService.Update(context.Background(), userID.Hex(), taskID.Hex(), updateReq)
 
 	// Assert
 	assert.NoError(t, err)
@@ -252,13 +426,21 @@ func TestTaskService_Update_Success(t *testing.T) {
 func TestTaskService_Update_PastDueDate(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	futureDate := time.Now().Add(24 * time.Hour)
 	taskID := primitive.NewObjectID()
 	existingTask := &model.Task{
 		ID:          taskID,
+		UserID:      userID,
 		Title:       "Test Task",
 		Description: "Test Description",
 		Status:      model.TaskStatusPending,
@@ -279,8 +461,15 @@ func TestTaskService_Update_PastDueDate(t *testing.T) {
 		Return(existingTask, nil).
 		Once()
 
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskUpdate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
 	// Execute
-	updatedTask, err := taskService.Update(context.Background(), taskID.Hex(), updateReq)
+	updatedTask, err := taskService.Update(context.Background(), userID.Hex(), taskID.Hex(), updateReq)
 
 	// Assert
 	assert.Error(t, err)
@@ -291,19 +480,49 @@ func TestTaskService_Update_PastDueDate(t *testing.T) {
 func TestTaskService_Delete_Success(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	taskID := primitive.NewObjectID()
+	attachment := model.NewAttachment(taskID, userID, "report.pdf", "application/pdf")
 
 	// Mock expectations
 	mockTaskRepo.EXPECT().
-		Delete(mock.Anything, taskID).
+		FindByID(mock.Anything, taskID).
+		Return(&model.Task{ID: taskID, UserID: userID, Attachments: []model.Attachment{*attachment}}, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		Delete(mock.Anything, userID, taskID).
+		Return(nil).
+		Once()
+
+	mockBlobStore.EXPECT().
+		Delete(mock.Anything, attachment.StorageKey).
+		Return(nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		DeleteByTask(mock.Anything, taskID).
 		Return(nil).
 		Once()
 
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskDelete && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
 	// Execute
-	err := taskService.Delete(context.Background(), taskID.Hex())
+	err := taskService.Delete(context.Background(), userID.Hex(), taskID.Hex())
 
 	// Assert
 	assert.NoError(t, err)
@@ -312,10 +531,27 @@ func TestTaskService_Delete_Success(t *testing.T) {
 func TestTaskService_Delete_InvalidID(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+
+	// Mock expectations
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskDelete && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
 
 	// Execute with invalid ID
-	err := taskService.Delete(context.Background(), "invalid-id")
+	err := taskService.Delete(context.Background(), userID.Hex(), "invalid-id")
 
 	// Assert
 	assert.Error(t, err)
@@ -325,21 +561,632 @@ func TestTaskService_Delete_InvalidID(t *testing.T) {
 func TestTaskService_Delete_NotFound(t *testing.T) {
 	// Setup
 	mockTaskRepo := mocks.NewMockTaskRepository(t)
-	taskService := NewTaskService(mockTaskRepo)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
 
 	// Test data
+	userID := primitive.NewObjectID()
 	taskID := primitive.NewObjectID()
 
 	// Mock expectations
 	mockTaskRepo.EXPECT().
-		Delete(mock.Anything, taskID).
-		Return(errors.New("task not found")).
+		FindByID(mock.Anything, taskID).
+		Return(nil, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskDelete && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
 		Once()
 
 	// Execute
-	err := taskService.Delete(context.Background(), taskID.Hex())
+	err := taskService.Delete(context.Background(), userID.Hex(), taskID.Hex())
 
 	// Assert
 	assert.Error(t, err)
 	assert.Equal(t, "task not found", err.Error())
 }
+
+func TestTaskService_Create_ProjectViewerForbidden(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	viewerID := primitive.NewObjectID()
+	project := model.NewProject("Test Project", "", primitive.NewObjectID())
+	project.ID = primitive.NewObjectID()
+	project.Members = append(project.Members, model.ProjectMember{UserID: viewerID, Role: model.ProjectRoleViewer, AddedAt: time.Now()})
+
+	req := dto.CreateTaskRequest{
+		Title:     "Test Task",
+		ProjectID: project.ID.Hex(),
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), viewerID.Hex(), req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "insufficient project permissions", err.Error())
+}
+
+func TestTaskService_Update_ProjectEditor(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	editorID := primitive.NewObjectID()
+	project := model.NewProject("Test Project", "", primitive.NewObjectID())
+	project.ID = primitive.NewObjectID()
+	project.Members = append(project.Members, model.ProjectMember{UserID: editorID, Role: model.ProjectRoleEditor, AddedAt: time.Now()})
+
+	taskID := primitive.NewObjectID()
+	existingTask := &model.Task{
+		ID:        taskID,
+		UserID:    project.CreatedBy,
+		ProjectID: &project.ID,
+		Title:     "Old Title",
+		Status:    model.TaskStatusPending,
+		Priority:  2,
+	}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(existingTask, nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		Update(mock.Anything, mock.MatchedBy(func(task *model.Task) bool {
+			return task.Title == "New Title"
+		})).
+		Return(nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskUpdate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
+	// Execute
+	task, err := taskService.Update(context.Background(), editorID.Hex(), taskID.Hex(), dto.UpdateTaskRequest{Title: "New Title"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "New Title", task.Title)
+}
+
+func TestTaskService_Create_WithAssignees(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	assignee := &model.User{
+		ID:          primitive.NewObjectID(),
+		Email:       "assignee@example.com",
+		DisplayName: "Assignee",
+	}
+	project := model.NewProject("Test Project", "", userID)
+	project.ID = primitive.NewObjectID()
+	project.Members = append(project.Members, model.ProjectMember{UserID: assignee.ID, Role: model.ProjectRoleEditor})
+
+	req := dto.CreateTaskRequest{
+		Title:       "Test Task",
+		ProjectID:   project.ID.Hex(),
+		AssigneeIDs: []string{assignee.ID.Hex(), assignee.ID.Hex()},
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, assignee.ID).
+		Return(assignee, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(task *model.Task) bool {
+			return len(task.AssigneeIDs) == 1 && task.AssigneeIDs[0] == assignee.ID
+		})).
+		Return(nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByIDs(mock.Anything, []primitive.ObjectID{assignee.ID}).
+		Return([]model.User{*assignee}, nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		FindByMember(mock.Anything, userID).
+		Return([]model.Project{*project}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeSuccess
+		})).
+		Return().
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, task.Assignees, 1)
+	assert.Equal(t, "assignee@example.com", task.Assignees[0].Email)
+	assert.Equal(t, "Assignee", task.Assignees[0].DisplayName)
+}
+
+func TestTaskService_Create_AssigneeNotFound(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	assigneeID := userID
+
+	req := dto.CreateTaskRequest{
+		Title:       "Test Task",
+		AssigneeIDs: []string{assigneeID.Hex()},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, assigneeID).
+		Return(nil, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "assignee not found", err.Error())
+}
+
+func TestTaskService_Create_PersonalTaskAssignedToOtherUser(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+
+	req := dto.CreateTaskRequest{
+		Title:       "Test Task",
+		AssigneeIDs: []string{userID.Hex(), primitive.NewObjectID().Hex()},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, userID).
+		Return(&model.User{ID: userID}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "personal tasks can only be assigned to their creator", err.Error())
+}
+
+func TestTaskService_Create_AssigneeNotProjectMember(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	ownerID := primitive.NewObjectID()
+	project := model.NewProject("Test Project", "", ownerID)
+	project.ID = primitive.NewObjectID()
+	outsider := &model.User{ID: primitive.NewObjectID(), Email: "outsider@example.com"}
+
+	req := dto.CreateTaskRequest{
+		Title:       "Test Task",
+		ProjectID:   project.ID.Hex(),
+		AssigneeIDs: []string{outsider.ID.Hex()},
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByID(mock.Anything, project.ID).
+		Return(project, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, outsider.ID).
+		Return(outsider, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), ownerID.Hex(), req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "assignee is not a project member", err.Error())
+}
+
+func TestTaskService_Update_AssigneeCannotChangePersonalTask(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	assigneeID := primitive.NewObjectID()
+	taskID := primitive.NewObjectID()
+	existingTask := &model.Task{
+		ID:          taskID,
+		UserID:      primitive.NewObjectID(),
+		AssigneeIDs: []primitive.ObjectID{assigneeID},
+		Title:       "Test Task",
+		Status:      model.TaskStatusPending,
+	}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(existingTask, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskUpdate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Update(context.Background(), assigneeID.Hex(), taskID.Hex(), dto.UpdateTaskRequest{Title: "New Title"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "only the task creator can change this task", err.Error())
+}
+
+func TestTaskService_List_OnlyMemberProjects(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	project := model.Project{ID: primitive.NewObjectID()}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByMember(mock.Anything, userID).
+		Return([]model.Project{project}, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		Find(mock.Anything, mock.MatchedBy(func(filters repository.TaskFilters) bool {
+			return filters.UserID == userID &&
+				len(filters.MemberProjectIDs) == 1 &&
+				filters.MemberProjectIDs[0] == project.ID
+		})).
+		Return([]model.Task{}, int64(0), nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Maybe()
+
+	// Execute
+	tasks, _, err := taskService.List(context.Background(), userID.Hex(), dto.TaskQueryParams{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestTaskService_List_AssignedToMe(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID(), Email: "me@example.com"}
+	expectedTasks := []model.Task{
+		{
+			ID:          primitive.NewObjectID(),
+			UserID:      primitive.NewObjectID(),
+			AssigneeIDs: []primitive.ObjectID{user.ID},
+			Title:       "Task 1",
+			Status:      model.TaskStatusPending,
+		},
+	}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		Find(mock.Anything, mock.MatchedBy(func(filters repository.TaskFilters) bool {
+			return filters.AssigneeID == user.ID && filters.UserID.IsZero() && filters.ProjectID.IsZero()
+		})).
+		Return(expectedTasks, int64(1), nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByIDs(mock.Anything, []primitive.ObjectID{user.ID}).
+		Return([]model.User{*user}, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, mock.AnythingOfType("[]primitive.ObjectID")).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
+	// Execute
+	tasks, meta, err := taskService.List(context.Background(), user.ID.Hex(), dto.TaskQueryParams{AssignedToMe: true})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "me@example.com", tasks[0].Assignees[0].Email)
+	assert.Equal(t, int64(1), meta.Total)
+}
+
+func TestTaskService_Create_UnknownTag(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	req := dto.CreateTaskRequest{
+		Title: "Test Task",
+		Tags:  []string{"bug", " frontend ", "bug"},
+	}
+
+	// Mock expectations
+	mockLabelRepo.EXPECT().
+		FindByNames(mock.Anything, []string{"bug", "frontend"}).
+		Return([]model.Label{{ID: primitive.NewObjectID(), Name: "bug"}}, nil).
+		Once()
+
+	mockAuditService.EXPECT().
+		Record(mock.Anything, mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditActionTaskCreate && entry.Outcome == model.AuditOutcomeFailure
+		})).
+		Return().
+		Once()
+
+	// Execute
+	task, err := taskService.Create(context.Background(), userID.Hex(), req)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, "label not found", err.Error())
+}
+
+func TestTaskService_List_TagsAllOf(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	params := dto.TaskQueryParams{
+		Tags:      "bug, frontend,,",
+		TagsMatch: "all",
+	}
+
+	// Mock expectations
+	mockProjectRepo.EXPECT().
+		FindByMember(mock.Anything, userID).
+		Return([]model.Project{}, nil).
+		Once()
+
+	mockTaskRepo.EXPECT().
+		Find(mock.Anything, mock.MatchedBy(func(filters repository.TaskFilters) bool {
+			return filters.UserID == userID &&
+				len(filters.Tags) == 2 &&
+				filters.Tags[0] == "bug" &&
+				filters.Tags[1] == "frontend" &&
+				filters.TagsMatch == "all"
+		})).
+		Return([]model.Task{}, int64(0), nil).
+		Once()
+
+	// Execute
+	tasks, meta, err := taskService.List(context.Background(), userID.Hex(), params)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 0)
+	assert.Equal(t, int64(0), meta.Total)
+}
+
+func TestTaskService_GetByID_HidesEmailOfUnrelatedAssignee(t *testing.T) {
+	// Setup
+	mockTaskRepo := mocks.NewMockTaskRepository(t)
+	mockProjectRepo := mocks.NewMockProjectRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockLabelRepo := mocks.NewMockLabelRepository(t)
+	mockCommentRepo := mocks.NewMockCommentRepository(t)
+	mockBlobStore := mocks.NewMockBlobStore(t)
+	mockAuditService := mocks.NewMockAuditService(t)
+	taskService := NewTaskService(mockTaskRepo, mockProjectRepo, mockUserRepo, mockLabelRepo, mockCommentRepo, mockBlobStore, mockAuditService)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	// assigned before personal tasks were limited to their creator
+	stranger := &model.User{ID: primitive.NewObjectID(), Email: "stranger@example.com", DisplayName: "Stranger"}
+	taskID := primitive.NewObjectID()
+	existingTask := &model.Task{
+		ID:          taskID,
+		UserID:      userID,
+		AssigneeIDs: []primitive.ObjectID{stranger.ID},
+		Title:       "Test Task",
+		Status:      model.TaskStatusPending,
+	}
+
+	// Mock expectations
+	mockTaskRepo.EXPECT().
+		FindByID(mock.Anything, taskID).
+		Return(existingTask, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByIDs(mock.Anything, []primitive.ObjectID{stranger.ID}).
+		Return([]model.User{*stranger}, nil).
+		Once()
+
+	mockProjectRepo.EXPECT().
+		FindByMember(mock.Anything, userID).
+		Return([]model.Project{*model.NewProject("Other Project", "", userID)}, nil).
+		Once()
+
+	mockCommentRepo.EXPECT().
+		CountByTasks(mock.Anything, []primitive.ObjectID{taskID}).
+		Return(map[primitive.ObjectID]int64{}, nil).
+		Once()
+
+	// Execute
+	task, err := taskService.GetByID(context.Background(), userID.Hex(), taskID.Hex())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, task.Assignees, 1)
+	assert.Equal(t, "Stranger", task.Assignees[0].DisplayName)
+	assert.Empty(t, task.Assignees[0].Email)
+}
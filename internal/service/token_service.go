@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/pkg/cache"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const tokenCacheSize = 10000
+
+// TokenService decides whether an otherwise valid access token has been revoked,
+// either individually (by jti), through its session or wholesale through the
+// user's token version.
+type TokenService interface {
+	Revoke(ctx context.Context, claims *util.JWTClaims) error
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeAllForUser(ctx context.Context, userID string) error
+	IsRevoked(ctx context.Context, claims *util.JWTClaims) (bool, error)
+}
+
+type tokenServiceImpl struct {
+	userRepo         repository.UserRepository
+	revokedTokenRepo repository.RevokedTokenRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	sessionRepo      repository.SessionRepository
+	config           *config.Config
+	revokedCache     *cache.TTLCache[string, bool]
+	versionCache     *cache.TTLCache[string, int]
+	sessionCache     *cache.TTLCache[string, bool]
+}
+
+func NewTokenService(userRepo repository.UserRepository, revokedTokenRepo repository.RevokedTokenRepository, refreshTokenRepo repository.RefreshTokenRepository, sessionRepo repository.SessionRepository, config *config.Config) TokenService {
+	return &tokenServiceImpl{
+		userRepo:         userRepo,
+		revokedTokenRepo: revokedTokenRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		sessionRepo:      sessionRepo,
+		config:           config,
+		revokedCache:     cache.NewTTLCache[string, bool](tokenCacheSize),
+		versionCache:     cache.NewTTLCache[string, int](tokenCacheSize),
+		sessionCache:     cache.NewTTLCache[string, bool](tokenCacheSize),
+	}
+}
+
+func (s *tokenServiceImpl) Revoke(ctx context.Context, claims *util.JWTClaims) error {
+	userID, err := primitive.ObjectIDFromHex(claims.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return errors.New("token cannot be revoked")
+	}
+
+	revoked := model.NewRevokedToken(claims.ID, userID, claims.ExpiresAt.Time)
+	if err := s.revokedTokenRepo.Create(ctx, revoked); err != nil {
+		return err
+	}
+
+	s.revokedCache.Set(claims.ID, true, time.Until(claims.ExpiresAt.Time))
+	return nil
+}
+
+// RevokeSession ends one of the user's sessions: its refresh tokens stop
+// working and access tokens carrying its ID are rejected from now on.
+func (s *tokenServiceImpl) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	ownerID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	id, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return errors.New("invalid session ID")
+	}
+
+	session, err := s.sessionRepo.Revoke(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	if session == nil {
+		return errors.New("session not found")
+	}
+
+	s.sessionCache.Set(sessionID, true, s.config.JWT.Expiry)
+
+	return s.refreshTokenRepo.RevokeFamily(ctx, session.FamilyID)
+}
+
+func (s *tokenServiceImpl) RevokeAllForUser(ctx context.Context, userID string) error {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	version, err := s.userRepo.IncrementTokenVersion(ctx, objectID)
+	if err != nil {
+		return err
+	}
+
+	s.versionCache.Set(userID, version, s.config.JWT.RevocationCacheTTL)
+
+	if err := s.sessionRepo.RevokeAllForUser(ctx, objectID); err != nil {
+		return err
+	}
+
+	return s.refreshTokenRepo.RevokeAllForUser(ctx, objectID)
+}
+
+func (s *tokenServiceImpl) IsRevoked(ctx context.Context, claims *util.JWTClaims) (bool, error) {
+	revoked, err := s.isTokenRevoked(ctx, claims)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	version, found, err := s.currentTokenVersion(ctx, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	// tokens of deleted users are treated as revoked
+	if !found || claims.TokenVersion < version {
+		return true, nil
+	}
+
+	// an impersonation token also ends with the admin's own tokens, e.g. when
+	// the admin is demoted, and with the admin's session
+	if claims.IsImpersonation() {
+		actorVersion, found, err := s.currentTokenVersion(ctx, claims.Actor.UserID)
+		if err != nil {
+			return false, err
+		}
+
+		if !found || claims.Actor.TokenVersion < actorVersion {
+			return true, nil
+		}
+
+		return s.isSessionRevoked(ctx, claims.Actor.SessionID, claims.Actor.UserID, claims)
+	}
+
+	return s.isSessionRevoked(ctx, claims.SessionID, claims.UserID, claims)
+}
+
+// isSessionRevoked reports whether the session the token was issued for has
+// ended. Tokens without a session (personal access tokens, tokens issued
+// before sessions existed) are left to the other checks.
+func (s *tokenServiceImpl) isSessionRevoked(ctx context.Context, sessionIDHex, userID string, claims *util.JWTClaims) (bool, error) {
+	if sessionIDHex == "" {
+		return false, nil
+	}
+
+	if revoked, ok := s.sessionCache.Get(sessionIDHex); ok {
+		return revoked, nil
+	}
+
+	sessionID, err := primitive.ObjectIDFromHex(sessionIDHex)
+	if err != nil {
+		return true, nil
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	revoked := session == nil || session.RevokedAt != nil || session.UserID.Hex() != userID
+
+	ttl := s.config.JWT.RevocationCacheTTL
+	if revoked && claims.ExpiresAt != nil {
+		ttl = time.Until(claims.ExpiresAt.Time)
+	}
+	s.sessionCache.Set(sessionIDHex, revoked, ttl)
+
+	return revoked, nil
+}
+
+func (s *tokenServiceImpl) isTokenRevoked(ctx context.Context, claims *util.JWTClaims) (bool, error) {
+	if claims.ID == "" {
+		return true, nil
+	}
+
+	if revoked, ok := s.revokedCache.Get(claims.ID); ok {
+		return revoked, nil
+	}
+
+	revoked, err := s.revokedTokenRepo.Exists(ctx, claims.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// revocations are permanent, so a positive answer can be kept for the token's
+	// whole lifetime; a negative one only briefly so other instances catch up
+	ttl := s.config.JWT.RevocationCacheTTL
+	if revoked && claims.ExpiresAt != nil {
+		ttl = time.Until(claims.ExpiresAt.Time)
+	}
+	s.revokedCache.Set(claims.ID, revoked, ttl)
+
+	return revoked, nil
+}
+
+func (s *tokenServiceImpl) currentTokenVersion(ctx context.Context, userID string) (int, bool, error) {
+	if version, ok := s.versionCache.Get(userID); ok {
+		return version, true, nil
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, false, errors.New("invalid user ID")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if user == nil {
+		return 0, false, nil
+	}
+
+	s.versionCache.Set(userID, user.TokenVersion, s.config.JWT.RevocationCacheTTL)
+
+	return user.TokenVersion, true, nil
+}
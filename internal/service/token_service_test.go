@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func newTestClaims(userID primitive.ObjectID, version int) *util.JWTClaims {
+	return &util.JWTClaims{
+		UserID:       userID.Hex(),
+		TokenVersion: version,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        primitive.NewObjectID().Hex(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(15 * time.Minute)),
+		},
+	}
+}
+
+func TestTokenService_IsRevoked_ValidToken(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID(), TokenVersion: 2}
+	claims := newTestClaims(user.ID, 2)
+
+	// Mock expectations: the second check must be served from the cache
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	first, err1 := tokenService.IsRevoked(context.Background(), claims)
+	second, err2 := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.False(t, first)
+	assert.False(t, second)
+}
+
+func TestTokenService_IsRevoked_RevokedTokenID(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	claims := newTestClaims(primitive.NewObjectID(), 0)
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(true, nil).
+		Once()
+
+	// Execute
+	revoked, err := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_IsRevoked_OutdatedTokenVersion(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID(), TokenVersion: 3}
+	claims := newTestClaims(user.ID, 2)
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	revoked, err := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_Revoke_IsVisibleImmediately(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	claims := newTestClaims(primitive.NewObjectID(), 0)
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Create(mock.Anything, mock.MatchedBy(func(token *model.RevokedToken) bool {
+			return token.ID == claims.ID && token.ExpiresAt.Equal(claims.ExpiresAt.Time)
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := tokenService.Revoke(context.Background(), claims)
+	revoked, checkErr := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, checkErr)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_RevokeAllForUser(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	claims := newTestClaims(userID, 0)
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		IncrementTokenVersion(mock.Anything, userID).
+		Return(1, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		RevokeAllForUser(mock.Anything, userID).
+		Return(nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		RevokeAllForUser(mock.Anything, userID).
+		Return(nil).
+		Once()
+
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	// Execute
+	err := tokenService.RevokeAllForUser(context.Background(), userID.Hex())
+	revoked, checkErr := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, checkErr)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_RevokeSession(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{Expiry: 15 * time.Minute, RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID()}
+	session := model.NewSession(user.ID, "family-1", "test-agent", "203.0.113.10", time.Hour)
+	session.ID = primitive.NewObjectID()
+	claims := newTestClaims(user.ID, 0)
+	claims.SessionID = session.ID.Hex()
+
+	// Mock expectations: the revoked session must be served from the cache
+	mockSessionRepo.EXPECT().
+		Revoke(mock.Anything, user.ID, session.ID).
+		Return(session, nil).
+		Once()
+
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(mock.Anything, "family-1").
+		Return(nil).
+		Once()
+
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	err := tokenService.RevokeSession(context.Background(), user.ID.Hex(), session.ID.Hex())
+	revoked, checkErr := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, checkErr)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_RevokeSession_NotFound(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{Expiry: 15 * time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	userID := primitive.NewObjectID()
+	sessionID := primitive.NewObjectID()
+
+	// Mock expectations
+	mockSessionRepo.EXPECT().
+		Revoke(mock.Anything, userID, sessionID).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	err := tokenService.RevokeSession(context.Background(), userID.Hex(), sessionID.Hex())
+
+	// Assert
+	assert.EqualError(t, err, "session not found")
+}
+
+func TestTokenService_IsRevoked_RevokedSession(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID()}
+	revokedAt := time.Now()
+	session := model.NewSession(user.ID, "family-1", "test-agent", "203.0.113.10", time.Hour)
+	session.ID = primitive.NewObjectID()
+	session.RevokedAt = &revokedAt
+	claims := newTestClaims(user.ID, 0)
+	claims.SessionID = session.ID.Hex()
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		FindByID(mock.Anything, session.ID).
+		Return(session, nil).
+		Once()
+
+	// Execute
+	revoked, err := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_IsRevoked_ImpersonationEndsWithAdminSession(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data
+	user := &model.User{ID: primitive.NewObjectID()}
+	admin := &model.User{ID: primitive.NewObjectID(), Role: model.RoleAdmin}
+	revokedAt := time.Now()
+	adminSession := model.NewSession(admin.ID, "family-1", "test-agent", "203.0.113.10", time.Hour)
+	adminSession.ID = primitive.NewObjectID()
+	adminSession.RevokedAt = &revokedAt
+	claims := newTestClaims(user.ID, 0)
+	claims.Actor = &util.Actor{UserID: admin.ID.Hex(), SessionID: adminSession.ID.Hex()}
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, admin.ID).
+		Return(admin, nil).
+		Once()
+
+	mockSessionRepo.EXPECT().
+		FindByID(mock.Anything, adminSession.ID).
+		Return(adminSession, nil).
+		Once()
+
+	// Execute
+	revoked, err := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestTokenService_IsRevoked_ImpersonationEndsWithAdminTokenVersion(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockRevokedTokenRepo := mocks.NewMockRevokedTokenRepository(t)
+	mockRefreshTokenRepo := mocks.NewMockRefreshTokenRepository(t)
+	mockSessionRepo := mocks.NewMockSessionRepository(t)
+	cfg := &config.Config{JWT: config.JWTConfig{RevocationCacheTTL: time.Minute}}
+
+	tokenService := NewTokenService(mockUserRepo, mockRevokedTokenRepo, mockRefreshTokenRepo, mockSessionRepo, cfg)
+
+	// Test data: the admin was demoted after starting the impersonation
+	user := &model.User{ID: primitive.NewObjectID()}
+	admin := &model.User{ID: primitive.NewObjectID(), Role: model.RoleMember, TokenVersion: 1}
+	claims := newTestClaims(user.ID, 0)
+	claims.Actor = &util.Actor{UserID: admin.ID.Hex(), TokenVersion: 0, SessionID: primitive.NewObjectID().Hex()}
+
+	// Mock expectations
+	mockRevokedTokenRepo.EXPECT().
+		Exists(mock.Anything, claims.ID).
+		Return(false, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, admin.ID).
+		Return(admin, nil).
+		Once()
+
+	// Execute
+	revoked, err := tokenService.IsRevoked(context.Background(), claims)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
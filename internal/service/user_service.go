@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type UserService interface {
+	GetProfile(ctx context.Context, userID string) (*model.User, error)
+	UpdateProfile(ctx context.Context, userID string, req dto.UpdateProfileRequest) (*model.User, error)
+	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
+	List(ctx context.Context, params dto.UserQueryParams) ([]model.User, dto.PaginationMeta, error)
+	UpdateRole(ctx context.Context, actorID, id, role string) (*model.User, error)
+}
+
+type userServiceImpl struct {
+	userRepo     repository.UserRepository
+	tokenService TokenService
+	config       *config.Config
+}
+
+func NewUserService(userRepo repository.UserRepository, tokenService TokenService, config *config.Config) UserService {
+	return &userServiceImpl{
+		userRepo:     userRepo,
+		tokenService: tokenService,
+		config:       config,
+	}
+}
+
+func (s *userServiceImpl) GetProfile(ctx context.Context, userID string) (*model.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	return user, nil
+}
+
+func (s *userServiceImpl) UpdateProfile(ctx context.Context, userID string, req dto.UpdateProfileRequest) (*model.User, error) {
+	user, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DisplayName != nil {
+		user.DisplayName = strings.TrimSpace(*req.DisplayName)
+	}
+
+	if req.Timezone != nil {
+		user.Timezone = *req.Timezone
+	}
+
+	if req.Locale != nil {
+		user.Locale = *req.Locale
+	}
+
+	if err := s.userRepo.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces the password after verifying the current one and
+// signs the user out everywhere, including the session that made the change.
+func (s *userServiceImpl) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	user, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if match, _ := util.VerifyPassword(currentPassword, user.Password, &s.config.Password); !match {
+		return errors.New("current password is incorrect")
+	}
+
+	if currentPassword == newPassword {
+		return errors.New("new password must be different from the current password")
+	}
+
+	if err := util.ValidatePassword(newPassword, user.Email, &s.config.Password); err != nil {
+		return err
+	}
+
+	hashedPassword, err := util.HashPassword(newPassword, &s.config.Password)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
+
+	return s.tokenService.RevokeAllForUser(ctx, userID)
+}
+
+func (s *userServiceImpl) List(ctx context.Context, params dto.UserQueryParams) ([]model.User, dto.PaginationMeta, error) {
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 10
+	}
+	if params.Limit > 100 {
+		params.Limit = 100
+	}
+
+	filters := repository.UserFilters{
+		Role:   params.Role,
+		Search: params.Search,
+		Page:   params.Page,
+		Limit:  params.Limit,
+	}
+
+	users, total, err := s.userRepo.Find(ctx, filters)
+	if err != nil {
+		return nil, dto.PaginationMeta{}, err
+	}
+
+	totalPages := int(math.Ceil(float64(total) / float64(params.Limit)))
+
+	meta := dto.PaginationMeta{
+		Total:      total,
+		Page:       params.Page,
+		Limit:      params.Limit,
+		TotalPages: totalPages,
+	}
+
+	return users, meta, nil
+}
+
+// UpdateRole changes a user's role and revokes their outstanding tokens, since
+// the role is carried inside the JWT.
+func (s *userServiceImpl) UpdateRole(ctx context.Context, actorID, id, role string) (*model.User, error) {
+	if !model.IsValidRole(role) {
+		return nil, errors.New("invalid role")
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	if id == actorID {
+		return nil, errors.New("cannot change your own role")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.GetRole() == model.Role(role) {
+		return user, nil
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, user.ID, model.Role(role)); err != nil {
+		return nil, err
+	}
+	user.Role = model.Role(role)
+
+	if err := s.tokenService.RevokeAllForUser(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
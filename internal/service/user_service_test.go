@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
+	"github.com/grachmannico95/mileapp-test-be/internal/repository"
+	"github.com/grachmannico95/mileapp-test-be/internal/util"
+	"github.com/grachmannico95/mileapp-test-be/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUserService_List_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	params := dto.UserQueryParams{
+		Role:   "viewer",
+		Search: "example",
+	}
+	users := []model.User{
+		{ID: primitive.NewObjectID(), Email: "a@example.com", Role: model.RoleViewer},
+		{ID: primitive.NewObjectID(), Email: "b@example.com", Role: model.RoleViewer},
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		Find(mock.Anything, repository.UserFilters{
+			Role:   "viewer",
+			Search: "example",
+			Page:   1,
+			Limit:  10,
+		}).
+		Return(users, int64(12), nil).
+		Once()
+
+	// Execute
+	result, meta, err := userService.List(context.Background(), params)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, int64(12), meta.Total)
+	assert.Equal(t, 1, meta.Page)
+	assert.Equal(t, 10, meta.Limit)
+	assert.Equal(t, 2, meta.TotalPages)
+}
+
+func TestUserService_UpdateRole_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	actorID := primitive.NewObjectID()
+	user := &model.User{
+		ID:    primitive.NewObjectID(),
+		Email: "member@example.com",
+		Role:  model.RoleMember,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateRole(mock.Anything, user.ID, model.RoleViewer).
+		Return(nil).
+		Once()
+
+	mockTokenService.EXPECT().
+		RevokeAllForUser(mock.Anything, user.ID.Hex()).
+		Return(nil).
+		Once()
+
+	// Execute
+	result, err := userService.UpdateRole(context.Background(), actorID.Hex(), user.ID.Hex(), "viewer")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoleViewer, result.Role)
+}
+
+func TestUserService_UpdateRole_OwnRole(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	actorID := primitive.NewObjectID().Hex()
+
+	// Execute
+	result, err := userService.UpdateRole(context.Background(), actorID, actorID, "viewer")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "cannot change your own role", err.Error())
+}
+
+func TestUserService_UpdateRole_NotFound(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	actorID := primitive.NewObjectID()
+	userID := primitive.NewObjectID()
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, userID).
+		Return(nil, nil).
+		Once()
+
+	// Execute
+	result, err := userService.UpdateRole(context.Background(), actorID.Hex(), userID.Hex(), "admin")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "user not found", err.Error())
+}
+
+func TestUserService_UpdateRole_InvalidRole(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Execute
+	result, err := userService.UpdateRole(context.Background(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), "owner")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, "invalid role", err.Error())
+}
+
+func TestUserService_UpdateProfile_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	user := &model.User{
+		ID:          primitive.NewObjectID(),
+		Email:       "test@example.com",
+		DisplayName: "Old Name",
+		Locale:      "en-US",
+	}
+	displayName := "  New Name "
+	timezone := "Asia/Jakarta"
+	req := dto.UpdateProfileRequest{
+		DisplayName: &displayName,
+		Timezone:    &timezone,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdateProfile(mock.Anything, mock.MatchedBy(func(u *model.User) bool {
+			return u.DisplayName == "New Name" &&
+				u.Timezone == timezone &&
+				u.Locale == "en-US"
+		})).
+		Return(nil).
+		Once()
+
+	// Execute
+	result, err := userService.UpdateProfile(context.Background(), user.ID.Hex(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "New Name", result.DisplayName)
+	assert.Equal(t, timezone, result.Timezone)
+	assert.Equal(t, "en-US", result.Locale)
+}
+
+func TestUserService_ChangePassword_Success(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	currentPassword := "Password123"
+	newPassword := "NewPassword456"
+	hashedPassword, _ := util.HashPassword(currentPassword, &cfg.Password)
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    "test@example.com",
+		Password: hashedPassword,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	mockUserRepo.EXPECT().
+		UpdatePassword(mock.Anything, user.ID, mock.MatchedBy(func(hash string) bool {
+			match, _ := util.VerifyPassword(newPassword, hash, &cfg.Password)
+			return match
+		})).
+		Return(nil).
+		Once()
+
+	mockTokenService.EXPECT().
+		RevokeAllForUser(mock.Anything, user.ID.Hex()).
+		Return(nil).
+		Once()
+
+	// Execute
+	err := userService.ChangePassword(context.Background(), user.ID.Hex(), currentPassword, newPassword)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestUserService_ChangePassword_WrongCurrentPassword(t *testing.T) {
+	// Setup
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	mockTokenService := mocks.NewMockTokenService(t)
+	cfg := &config.Config{}
+	userService := NewUserService(mockUserRepo, mockTokenService, cfg)
+
+	// Test data
+	hashedPassword, _ := util.HashPassword("Password123", &cfg.Password)
+	user := &model.User{
+		ID:       primitive.NewObjectID(),
+		Email:    "test@example.com",
+		Password: hashedPassword,
+	}
+
+	// Mock expectations
+	mockUserRepo.EXPECT().
+		FindByID(mock.Anything, user.ID).
+		Return(user, nil).
+		Once()
+
+	// Execute
+	err := userService.ChangePassword(context.Background(), user.ID.Hex(), "WrongPassword1", "NewPassword456")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "current password is incorrect", err.Error())
+}
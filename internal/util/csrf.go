@@ -5,39 +5,80 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-func GenerateCSRFToken(secret string) string {
+// GenerateCSRFToken returns a token of the form "<id>.<expiry>.<signature>".
+// The signature also covers subject, which is not part of the token itself, so
+// the token is only accepted for the session it was issued to.
+func GenerateCSRFToken(subject string, expiry time.Duration, secret string) string {
 	tokenID := uuid.New().String()
+	expiresAt := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
 
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(tokenID))
-	signature := h.Sum(nil)
+	signature := signCSRFToken(tokenID, expiresAt, subject, secret)
 
-	token := fmt.Sprintf("%s.%s", tokenID, base64.URLEncoding.EncodeToString(signature))
+	token := fmt.Sprintf("%s.%s.%s", tokenID, expiresAt, base64.URLEncoding.EncodeToString(signature))
 	return token
 }
 
-func ValidateCSRFToken(token, secret string) bool {
-	parts := strings.SplitN(token, ".", 2)
-	if len(parts) != 2 {
+func ValidateCSRFToken(token, subject, secret string) bool {
+	if subject == "" {
+		return false
+	}
+
+	parts := strings.SplitN(token, ".", 3)
+	if len(parts) != 3 {
 		return false
 	}
 
 	tokenID := parts[0]
-	signatureStr := parts[1]
+	expiresAtStr := parts[1]
+	signatureStr := parts[2]
 
 	signature, err := base64.URLEncoding.DecodeString(signatureStr)
 	if err != nil {
 		return false
 	}
 
-	h := hmac.New(sha256.New, []byte(secret))
-	h.Write([]byte(tokenID))
-	expectedSignature := h.Sum(nil)
+	expectedSignature := signCSRFToken(tokenID, expiresAtStr, subject, secret)
+	if !hmac.Equal(signature, expectedSignature) {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(expiresAtStr, 10, 64)
+	if err != nil {
+		return false
+	}
 
-	return hmac.Equal(signature, expectedSignature)
+	return time.Now().Unix() <= expiresAt
+}
+
+// CSRFSubject is the value CSRF tokens are bound to: the login session when the
+// access token carries one, the user otherwise.
+func CSRFSubject(claims *JWTClaims) string {
+	if claims == nil {
+		return ""
+	}
+
+	// impersonation tokens get their own CSRF tokens, so neither the admin's
+	// nor the impersonated user's can be replayed with them
+	if claims.IsImpersonation() {
+		return "impersonation:" + claims.ID
+	}
+
+	if claims.SessionID != "" {
+		return "session:" + claims.SessionID
+	}
+
+	return "user:" + claims.UserID
+}
+
+func signCSRFToken(tokenID, expiresAt, subject, secret string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(tokenID + "." + expiresAt + "." + subject))
+	return h.Sum(nil)
 }
@@ -5,38 +5,93 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"go.mongodb.org/mongo-driver/bson/primitive"
+	"github.com/google/uuid"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
+	"github.com/grachmannico95/mileapp-test-be/internal/model"
 )
 
 type JWTClaims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID       string   `json:"user_id"`
+	Email        string   `json:"email"`
+	Role         string   `json:"role"`
+	TokenVersion int      `json:"token_version"`
+	Scopes       []string `json:"scopes,omitempty"`
+	SessionID    string   `json:"sid,omitempty"`
+	Actor        *Actor   `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(userID primitive.ObjectID, email, secret string, expiry time.Duration) (string, error) {
+// Actor is the admin behind an impersonation token, carried in the "act"
+// claim as in RFC 8693. The token itself describes the impersonated user; the
+// actor's token version and session keep it tied to the admin's login.
+type Actor struct {
+	UserID       string `json:"sub"`
+	Email        string `json:"email"`
+	TokenVersion int    `json:"token_version"`
+	SessionID    string `json:"sid,omitempty"`
+}
+
+// IsImpersonation reports whether the token was issued to an admin acting as
+// another user.
+func (c *JWTClaims) IsImpersonation() bool {
+	return c.Actor != nil
+}
+
+func GenerateJWT(user *model.User, sessionID string, cfg *config.JWTConfig) (string, error) {
+	claims := newJWTClaims(user, cfg.Expiry, cfg)
+	claims.SessionID = sessionID
+
+	return cfg.Keys().Sign(claims)
+}
+
+// GenerateImpersonationJWT issues a token for user on behalf of actor. It is
+// not bound to a session of the impersonated user and cannot be refreshed.
+func GenerateImpersonationJWT(user *model.User, actor *Actor, expiry time.Duration, cfg *config.JWTConfig) (string, *JWTClaims, error) {
+	claims := newJWTClaims(user, expiry, cfg)
+	claims.Actor = actor
+
+	token, err := cfg.Keys().Sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return token, &claims, nil
+}
+
+func newJWTClaims(user *model.User, expiry time.Duration, cfg *config.JWTConfig) JWTClaims {
 	claims := JWTClaims{
-		UserID: userID.Hex(),
-		Email:  email,
+		UserID:       user.ID.Hex(),
+		Email:        user.Email,
+		Role:         string(user.GetRole()),
+		TokenVersion: user.TokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    cfg.Issuer,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	if cfg.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{cfg.Audience}
+	}
+
+	return claims
 }
 
-func ValidateJWT(tokenString, secret string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(secret), nil
-	})
+func ValidateJWT(tokenString string, cfg *config.JWTConfig) (*JWTClaims, error) {
+	keys := cfg.Keys()
+
+	options := []jwt.ParserOption{jwt.WithValidMethods(keys.ValidMethods())}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
 
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, keys.Keyfunc, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,20 +1,23 @@
 package util
 
 import (
-	"golang.org/x/crypto/bcrypt"
+	"github.com/grachmannico95/mileapp-test-be/internal/config"
 )
 
-func HashPassword(password string) (string, error) {
-	bcryptCost := 12
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
-	if err != nil {
-		return "", err
-	}
+func HashPassword(password string, cfg *config.PasswordConfig) (string, error) {
+	return cfg.Hasher().Hash(password)
+}
 
-	return string(hashedBytes), nil
+// VerifyPassword checks password against hash. needsRehash reports that the
+// password matched but hash uses an outdated algorithm or cost and should be
+// replaced with a fresh HashPassword result.
+func VerifyPassword(password, hash string, cfg *config.PasswordConfig) (match bool, needsRehash bool) {
+	return cfg.Hasher().Verify(password, hash)
 }
 
-func CheckPasswordHash(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+// ValidatePassword checks a new password against the configured policy. email
+// may be empty when the account's address is not known. Failures are returned
+// as a *password.PolicyError listing every broken rule.
+func ValidatePassword(password, email string, cfg *config.PasswordConfig) error {
+	return cfg.Policy().Check(password, email)
 }
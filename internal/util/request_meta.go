@@ -0,0 +1,25 @@
+package util
+
+import "context"
+
+type requestMetaKey struct{}
+
+// RequestMeta describes the client behind a request, for services that record
+// it without taking it as an argument. ImpersonatorID is set once the request
+// is known to be made by an admin impersonating the authenticated user.
+type RequestMeta struct {
+	IP             string
+	UserAgent      string
+	ImpersonatorID string
+}
+
+func WithRequestMeta(ctx context.Context, meta RequestMeta) context.Context {
+	return context.WithValue(ctx, requestMetaKey{}, meta)
+}
+
+// RequestMetaFromContext returns the metadata stored by WithRequestMeta, or an
+// empty value outside of a request.
+func RequestMetaFromContext(ctx context.Context) RequestMeta {
+	meta, _ := ctx.Value(requestMetaKey{}).(RequestMeta)
+	return meta
+}
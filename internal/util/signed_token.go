@@ -0,0 +1,46 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// SignToken returns payload together with an HMAC signature so it can be handed
+// to a client and later verified without storing it server-side.
+func SignToken(payload, secret string) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(payload))
+	signature := h.Sum(nil)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// VerifySignedToken returns the payload of a token created by SignToken and
+// reports whether its signature is valid.
+func VerifySignedToken(token, secret string) (string, bool) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return "", false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", false
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", false
+	}
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(payload)
+
+	if !hmac.Equal(signature, h.Sum(nil)) {
+		return "", false
+	}
+
+	return string(payload), true
+}
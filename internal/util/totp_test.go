@@ -0,0 +1,118 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfc6238Secret is the base32 form of the RFC 6238 SHA1 test key
+// "12345678901234567890".
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateTOTPCode_RFC6238Vectors(t *testing.T) {
+	// the RFC lists 8 digit codes; the 6 digit codes are their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(time.Unix(tt.unix, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			// Execute
+			code, err := GenerateTOTPCode(rfc6238Secret, TOTPStep(time.Unix(tt.unix, 0)))
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, code)
+		})
+	}
+}
+
+func TestGenerateTOTPCode_InvalidSecret(t *testing.T) {
+	// Execute
+	code, err := GenerateTOTPCode("not base32!", 1)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Empty(t, code)
+}
+
+func TestValidateTOTPCode(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := TOTPStep(now)
+
+	codeAt := func(step int64) string {
+		code, err := GenerateTOTPCode(rfc6238Secret, step)
+		assert.NoError(t, err)
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", code: codeAt(current), skew: 1, wantStep: current, wantOK: true},
+		{name: "previous step within skew", code: codeAt(current - 1), skew: 1, wantStep: current - 1, wantOK: true},
+		{name: "next step within skew", code: codeAt(current + 1), skew: 1, wantStep: current + 1, wantOK: true},
+		{name: "previous step without skew", code: codeAt(current - 1), skew: 0, wantOK: false},
+		{name: "two steps back outside skew", code: codeAt(current - 2), skew: 1, wantOK: false},
+		{name: "wrong code", code: "000000", skew: 1, wantOK: false},
+		{name: "too short", code: "12345", skew: 1, wantOK: false},
+		{name: "too long", code: "1234567", skew: 1, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			step, ok := ValidateTOTPCode(rfc6238Secret, tt.code, now, tt.skew)
+
+			// Assert
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantStep, step)
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	// Execute
+	secret, err := GenerateTOTPSecret()
+	other, _ := GenerateTOTPSecret()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, secret, 32)
+	assert.NotEqual(t, secret, other)
+
+	_, err = GenerateTOTPCode(secret, 1)
+	assert.NoError(t, err)
+}
+
+func TestTOTPURI(t *testing.T) {
+	// Execute
+	uri := TOTPURI("Task App", "user@example.com", rfc6238Secret)
+
+	// Assert
+	parsed, err := url.Parse(uri)
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.True(t, strings.HasSuffix(parsed.Path, "Task App:user@example.com"))
+	assert.Equal(t, rfc6238Secret, parsed.Query().Get("secret"))
+	assert.Equal(t, "Task App", parsed.Query().Get("issuer"))
+	assert.Equal(t, "6", parsed.Query().Get("digits"))
+	assert.Equal(t, "30", parsed.Query().Get("period"))
+}
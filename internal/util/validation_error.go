@@ -1,11 +1,13 @@
 package util
 
 import (
+	stderrors "errors"
 	"fmt"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/grachmannico95/mileapp-test-be/internal/dto"
+	"github.com/grachmannico95/mileapp-test-be/pkg/password"
 )
 
 func ParseValidationError(err error) []dto.ErrorItem {
@@ -21,6 +23,18 @@ func ParseValidationError(err error) []dto.ErrorItem {
 		return errors
 	}
 
+	var policyErr *password.PolicyError
+	if stderrors.As(err, &policyErr) {
+		for _, violation := range policyErr.Violations {
+			errors = append(errors, dto.ErrorItem{
+				Field:   "password",
+				Code:    violation.Rule,
+				Message: violation.Message,
+			})
+		}
+		return errors
+	}
+
 	return []dto.ErrorItem{
 		{
 			Message: err.Error(),
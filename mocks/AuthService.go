@@ -5,7 +5,9 @@ package mocks
 import (
 	context "context"
 
+	dto "github.com/grachmannico95/mileapp-test-be/internal/dto"
 	model "github.com/grachmannico95/mileapp-test-be/internal/model"
+	util "github.com/grachmannico95/mileapp-test-be/internal/util"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,48 +24,290 @@ func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
 	return &MockAuthService_Expecter{mock: &_m.Mock}
 }
 
-// Login provides a mock function with given fields: ctx, email, password
-func (_m *MockAuthService) Login(ctx context.Context, email string, password string) (*model.User, string, string, error) {
-	ret := _m.Called(ctx, email, password)
+// CompleteExternalLogin provides a mock function with given fields: ctx, user, ip, userAgent
+func (_m *MockAuthService) CompleteExternalLogin(ctx context.Context, user *model.User, ip string, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	ret := _m.Called(ctx, user, ip, userAgent)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Login")
+		panic("no return value specified for CompleteExternalLogin")
+	}
+
+	var r0 *model.User
+	var r1 *dto.AuthTokens
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User, string, string) (*model.User, *dto.AuthTokens, error)); ok {
+		return rf(ctx, user, ip, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.User, string, string) *model.User); ok {
+		r0 = rf(ctx, user, ip, userAgent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.User, string, string) *dto.AuthTokens); ok {
+		r1 = rf(ctx, user, ip, userAgent)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dto.AuthTokens)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.User, string, string) error); ok {
+		r2 = rf(ctx, user, ip, userAgent)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthService_CompleteExternalLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteExternalLogin'
+type MockAuthService_CompleteExternalLogin_Call struct {
+	*mock.Call
+}
+
+// CompleteExternalLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.User
+//   - ip string
+//   - userAgent string
+func (_e *MockAuthService_Expecter) CompleteExternalLogin(ctx interface{}, user interface{}, ip interface{}, userAgent interface{}) *MockAuthService_CompleteExternalLogin_Call {
+	return &MockAuthService_CompleteExternalLogin_Call{Call: _e.mock.On("CompleteExternalLogin", ctx, user, ip, userAgent)}
+}
+
+func (_c *MockAuthService_CompleteExternalLogin_Call) Run(run func(ctx context.Context, user *model.User, ip string, userAgent string)) *MockAuthService_CompleteExternalLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.User), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_CompleteExternalLogin_Call) Return(_a0 *model.User, _a1 *dto.AuthTokens, _a2 error) *MockAuthService_CompleteExternalLogin_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthService_CompleteExternalLogin_Call) RunAndReturn(run func(context.Context, *model.User, string, string) (*model.User, *dto.AuthTokens, error)) *MockAuthService_CompleteExternalLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteMFALogin provides a mock function with given fields: ctx, mfaToken, code, ip, userAgent
+func (_m *MockAuthService) CompleteMFALogin(ctx context.Context, mfaToken string, code string, ip string, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	ret := _m.Called(ctx, mfaToken, code, ip, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteMFALogin")
 	}
 
 	var r0 *model.User
-	var r1 string
-	var r2 string
-	var r3 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.User, string, string, error)); ok {
-		return rf(ctx, email, password)
+	var r1 *dto.AuthTokens
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*model.User, *dto.AuthTokens, error)); ok {
+		return rf(ctx, mfaToken, code, ip, userAgent)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.User); ok {
-		r0 = rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *model.User); ok {
+		r0 = rf(ctx, mfaToken, code, ip, userAgent)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) string); ok {
-		r1 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) *dto.AuthTokens); ok {
+		r1 = rf(ctx, mfaToken, code, ip, userAgent)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dto.AuthTokens)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string) error); ok {
+		r2 = rf(ctx, mfaToken, code, ip, userAgent)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthService_CompleteMFALogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteMFALogin'
+type MockAuthService_CompleteMFALogin_Call struct {
+	*mock.Call
+}
+
+// CompleteMFALogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mfaToken string
+//   - code string
+//   - ip string
+//   - userAgent string
+func (_e *MockAuthService_Expecter) CompleteMFALogin(ctx interface{}, mfaToken interface{}, code interface{}, ip interface{}, userAgent interface{}) *MockAuthService_CompleteMFALogin_Call {
+	return &MockAuthService_CompleteMFALogin_Call{Call: _e.mock.On("CompleteMFALogin", ctx, mfaToken, code, ip, userAgent)}
+}
+
+func (_c *MockAuthService_CompleteMFALogin_Call) Run(run func(ctx context.Context, mfaToken string, code string, ip string, userAgent string)) *MockAuthService_CompleteMFALogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_CompleteMFALogin_Call) Return(_a0 *model.User, _a1 *dto.AuthTokens, _a2 error) *MockAuthService_CompleteMFALogin_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthService_CompleteMFALogin_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*model.User, *dto.AuthTokens, error)) *MockAuthService_CompleteMFALogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInvite provides a mock function with given fields: ctx, userID, email
+func (_m *MockAuthService) CreateInvite(ctx context.Context, userID string, email string) (*model.Invite, error) {
+	ret := _m.Called(ctx, userID, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 *model.Invite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Invite, error)); ok {
+		return rf(ctx, userID, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Invite); ok {
+		r0 = rf(ctx, userID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Invite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_CreateInvite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInvite'
+type MockAuthService_CreateInvite_Call struct {
+	*mock.Call
+}
+
+// CreateInvite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - email string
+func (_e *MockAuthService_Expecter) CreateInvite(ctx interface{}, userID interface{}, email interface{}) *MockAuthService_CreateInvite_Call {
+	return &MockAuthService_CreateInvite_Call{Call: _e.mock.On("CreateInvite", ctx, userID, email)}
+}
+
+func (_c *MockAuthService_CreateInvite_Call) Run(run func(ctx context.Context, userID string, email string)) *MockAuthService_CreateInvite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_CreateInvite_Call) Return(_a0 *model.Invite, _a1 error) *MockAuthService_CreateInvite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_CreateInvite_Call) RunAndReturn(run func(context.Context, string, string) (*model.Invite, error)) *MockAuthService_CreateInvite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueCSRFToken provides a mock function with given fields: claims
+func (_m *MockAuthService) IssueCSRFToken(claims *util.JWTClaims) string {
+	ret := _m.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueCSRFToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*util.JWTClaims) string); ok {
+		r0 = rf(claims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockAuthService_IssueCSRFToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueCSRFToken'
+type MockAuthService_IssueCSRFToken_Call struct {
+	*mock.Call
+}
+
+// IssueCSRFToken is a helper method to define mock.On call
+//   - claims *util.JWTClaims
+func (_e *MockAuthService_Expecter) IssueCSRFToken(claims interface{}) *MockAuthService_IssueCSRFToken_Call {
+	return &MockAuthService_IssueCSRFToken_Call{Call: _e.mock.On("IssueCSRFToken", claims)}
+}
+
+func (_c *MockAuthService_IssueCSRFToken_Call) Run(run func(claims *util.JWTClaims)) *MockAuthService_IssueCSRFToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*util.JWTClaims))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_IssueCSRFToken_Call) Return(_a0 string) *MockAuthService_IssueCSRFToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthService_IssueCSRFToken_Call) RunAndReturn(run func(*util.JWTClaims) string) *MockAuthService_IssueCSRFToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, email, password, ip, userAgent
+func (_m *MockAuthService) Login(ctx context.Context, email string, password string, ip string, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	ret := _m.Called(ctx, email, password, ip, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *model.User
+	var r1 *dto.AuthTokens
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*model.User, *dto.AuthTokens, error)); ok {
+		return rf(ctx, email, password, ip, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *model.User); ok {
+		r0 = rf(ctx, email, password, ip, userAgent)
 	} else {
-		r1 = ret.Get(1).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, string) string); ok {
-		r2 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) *dto.AuthTokens); ok {
+		r1 = rf(ctx, email, password, ip, userAgent)
 	} else {
-		r2 = ret.Get(2).(string)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dto.AuthTokens)
+		}
 	}
 
-	if rf, ok := ret.Get(3).(func(context.Context, string, string) error); ok {
-		r3 = rf(ctx, email, password)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string) error); ok {
+		r2 = rf(ctx, email, password, ip, userAgent)
 	} else {
-		r3 = ret.Error(3)
+		r2 = ret.Error(2)
 	}
 
-	return r0, r1, r2, r3
+	return r0, r1, r2
 }
 
 // MockAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
@@ -75,30 +319,197 @@ type MockAuthService_Login_Call struct {
 //   - ctx context.Context
 //   - email string
 //   - password string
-func (_e *MockAuthService_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *MockAuthService_Login_Call {
-	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - ip string
+//   - userAgent string
+func (_e *MockAuthService_Expecter) Login(ctx interface{}, email interface{}, password interface{}, ip interface{}, userAgent interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", ctx, email, password, ip, userAgent)}
 }
 
-func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, email string, password string)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Login_Call) Run(run func(ctx context.Context, email string, password string, ip string, userAgent string)) *MockAuthService_Login_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) Return(_a0 *model.User, _a1 *dto.AuthTokens, _a2 error) *MockAuthService_Login_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(context.Context, string, string, string, string) (*model.User, *dto.AuthTokens, error)) *MockAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, claims, refreshToken
+func (_m *MockAuthService) Logout(ctx context.Context, claims *util.JWTClaims, refreshToken string) error {
+	ret := _m.Called(ctx, claims, refreshToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *util.JWTClaims, string) error); ok {
+		r0 = rf(ctx, claims, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claims *util.JWTClaims
+//   - refreshToken string
+func (_e *MockAuthService_Expecter) Logout(ctx interface{}, claims interface{}, refreshToken interface{}) *MockAuthService_Logout_Call {
+	return &MockAuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, claims, refreshToken)}
+}
+
+func (_c *MockAuthService_Logout_Call) Run(run func(ctx context.Context, claims *util.JWTClaims, refreshToken string)) *MockAuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*util.JWTClaims), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) Return(_a0 error) *MockAuthService_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthService_Logout_Call) RunAndReturn(run func(context.Context, *util.JWTClaims, string) error) *MockAuthService_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogoutAll provides a mock function with given fields: ctx, userID
+func (_m *MockAuthService) LogoutAll(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogoutAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthService_LogoutAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogoutAll'
+type MockAuthService_LogoutAll_Call struct {
+	*mock.Call
+}
+
+// LogoutAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockAuthService_Expecter) LogoutAll(ctx interface{}, userID interface{}) *MockAuthService_LogoutAll_Call {
+	return &MockAuthService_LogoutAll_Call{Call: _e.mock.On("LogoutAll", ctx, userID)}
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Run(run func(ctx context.Context, userID string)) *MockAuthService_LogoutAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) Return(_a0 error) *MockAuthService_LogoutAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthService_LogoutAll_Call) RunAndReturn(run func(context.Context, string) error) *MockAuthService_LogoutAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, refreshToken, ip, userAgent
+func (_m *MockAuthService) Refresh(ctx context.Context, refreshToken string, ip string, userAgent string) (*model.User, *dto.AuthTokens, error) {
+	ret := _m.Called(ctx, refreshToken, ip, userAgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *model.User
+	var r1 *dto.AuthTokens
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.User, *dto.AuthTokens, error)); ok {
+		return rf(ctx, refreshToken, ip, userAgent)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.User); ok {
+		r0 = rf(ctx, refreshToken, ip, userAgent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) *dto.AuthTokens); ok {
+		r1 = rf(ctx, refreshToken, ip, userAgent)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dto.AuthTokens)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string) error); ok {
+		r2 = rf(ctx, refreshToken, ip, userAgent)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAuthService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuthService_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshToken string
+//   - ip string
+//   - userAgent string
+func (_e *MockAuthService_Expecter) Refresh(ctx interface{}, refreshToken interface{}, ip interface{}, userAgent interface{}) *MockAuthService_Refresh_Call {
+	return &MockAuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken, ip, userAgent)}
+}
+
+func (_c *MockAuthService_Refresh_Call) Run(run func(ctx context.Context, refreshToken string, ip string, userAgent string)) *MockAuthService_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) Return(_a0 *model.User, _a1 string, _a2 string, _a3 error) *MockAuthService_Login_Call {
-	_c.Call.Return(_a0, _a1, _a2, _a3)
+func (_c *MockAuthService_Refresh_Call) Return(_a0 *model.User, _a1 *dto.AuthTokens, _a2 error) *MockAuthService_Refresh_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockAuthService_Login_Call) RunAndReturn(run func(context.Context, string, string) (*model.User, string, string, error)) *MockAuthService_Login_Call {
+func (_c *MockAuthService_Refresh_Call) RunAndReturn(run func(context.Context, string, string, string) (*model.User, *dto.AuthTokens, error)) *MockAuthService_Refresh_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: ctx, email, password
-func (_m *MockAuthService) Register(ctx context.Context, email string, password string) (*model.User, error) {
-	ret := _m.Called(ctx, email, password)
+// Register provides a mock function with given fields: ctx, email, password, inviteCode
+func (_m *MockAuthService) Register(ctx context.Context, email string, password string, inviteCode string) (*model.User, error) {
+	ret := _m.Called(ctx, email, password, inviteCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Register")
@@ -106,19 +517,19 @@ func (_m *MockAuthService) Register(ctx context.Context, email string, password
 
 	var r0 *model.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.User, error)); ok {
-		return rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.User, error)); ok {
+		return rf(ctx, email, password, inviteCode)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.User); ok {
-		r0 = rf(ctx, email, password)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.User); ok {
+		r0 = rf(ctx, email, password, inviteCode)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, email, password)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, email, password, inviteCode)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -135,13 +546,14 @@ type MockAuthService_Register_Call struct {
 //   - ctx context.Context
 //   - email string
 //   - password string
-func (_e *MockAuthService_Expecter) Register(ctx interface{}, email interface{}, password interface{}) *MockAuthService_Register_Call {
-	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, email, password)}
+//   - inviteCode string
+func (_e *MockAuthService_Expecter) Register(ctx interface{}, email interface{}, password interface{}, inviteCode interface{}) *MockAuthService_Register_Call {
+	return &MockAuthService_Register_Call{Call: _e.mock.On("Register", ctx, email, password, inviteCode)}
 }
 
-func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, email string, password string)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) Run(run func(ctx context.Context, email string, password string, inviteCode string)) *MockAuthService_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -151,7 +563,7 @@ func (_c *MockAuthService_Register_Call) Return(_a0 *model.User, _a1 error) *Moc
 	return _c
 }
 
-func (_c *MockAuthService_Register_Call) RunAndReturn(run func(context.Context, string, string) (*model.User, error)) *MockAuthService_Register_Call {
+func (_c *MockAuthService_Register_Call) RunAndReturn(run func(context.Context, string, string, string) (*model.User, error)) *MockAuthService_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -26,6 +26,102 @@ func (_m *MockTaskRepository) EXPECT() *MockTaskRepository_Expecter {
 	return &MockTaskRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddAttachment provides a mock function with given fields: ctx, taskID, attachment, maxPerTask
+func (_m *MockTaskRepository) AddAttachment(ctx context.Context, taskID primitive.ObjectID, attachment *model.Attachment, maxPerTask int) error {
+	ret := _m.Called(ctx, taskID, attachment, maxPerTask)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, *model.Attachment, int) error); ok {
+		r0 = rf(ctx, taskID, attachment, maxPerTask)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type MockTaskRepository_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID primitive.ObjectID
+//   - attachment *model.Attachment
+//   - maxPerTask int
+func (_e *MockTaskRepository_Expecter) AddAttachment(ctx interface{}, taskID interface{}, attachment interface{}, maxPerTask interface{}) *MockTaskRepository_AddAttachment_Call {
+	return &MockTaskRepository_AddAttachment_Call{Call: _e.mock.On("AddAttachment", ctx, taskID, attachment, maxPerTask)}
+}
+
+func (_c *MockTaskRepository_AddAttachment_Call) Run(run func(ctx context.Context, taskID primitive.ObjectID, attachment *model.Attachment, maxPerTask int)) *MockTaskRepository_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(*model.Attachment), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_AddAttachment_Call) Return(_a0 error) *MockTaskRepository_AddAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_AddAttachment_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, *model.Attachment, int) error) *MockTaskRepository_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockTaskRepository) ArchiveByProject(ctx context.Context, projectID primitive.ObjectID) error {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveByProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_ArchiveByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveByProject'
+type MockTaskRepository_ArchiveByProject_Call struct {
+	*mock.Call
+}
+
+// ArchiveByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID primitive.ObjectID
+func (_e *MockTaskRepository_Expecter) ArchiveByProject(ctx interface{}, projectID interface{}) *MockTaskRepository_ArchiveByProject_Call {
+	return &MockTaskRepository_ArchiveByProject_Call{Call: _e.mock.On("ArchiveByProject", ctx, projectID)}
+}
+
+func (_c *MockTaskRepository_ArchiveByProject_Call) Run(run func(ctx context.Context, projectID primitive.ObjectID)) *MockTaskRepository_ArchiveByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_ArchiveByProject_Call) Return(_a0 error) *MockTaskRepository_ArchiveByProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_ArchiveByProject_Call) RunAndReturn(run func(context.Context, primitive.ObjectID) error) *MockTaskRepository_ArchiveByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, task
 func (_m *MockTaskRepository) Create(ctx context.Context, task *model.Task) error {
 	ret := _m.Called(ctx, task)
@@ -73,17 +169,17 @@ func (_c *MockTaskRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *MockTaskRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *MockTaskRepository) Delete(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID) error {
+	ret := _m.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, userID, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,14 +194,15 @@ type MockTaskRepository_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID primitive.ObjectID
 //   - id primitive.ObjectID
-func (_e *MockTaskRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockTaskRepository_Delete_Call {
-	return &MockTaskRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *MockTaskRepository_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *MockTaskRepository_Delete_Call {
+	return &MockTaskRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
 }
 
-func (_c *MockTaskRepository_Delete_Call) Run(run func(ctx context.Context, id primitive.ObjectID)) *MockTaskRepository_Delete_Call {
+func (_c *MockTaskRepository_Delete_Call) Run(run func(ctx context.Context, userID primitive.ObjectID, id primitive.ObjectID)) *MockTaskRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(primitive.ObjectID))
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(primitive.ObjectID))
 	})
 	return _c
 }
@@ -115,7 +212,54 @@ func (_c *MockTaskRepository_Delete_Call) Return(_a0 error) *MockTaskRepository_
 	return _c
 }
 
-func (_c *MockTaskRepository_Delete_Call) RunAndReturn(run func(context.Context, primitive.ObjectID) error) *MockTaskRepository_Delete_Call {
+func (_c *MockTaskRepository_Delete_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, primitive.ObjectID) error) *MockTaskRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockTaskRepository) DeleteByProject(ctx context.Context, projectID primitive.ObjectID) error {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DeleteByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByProject'
+type MockTaskRepository_DeleteByProject_Call struct {
+	*mock.Call
+}
+
+// DeleteByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID primitive.ObjectID
+func (_e *MockTaskRepository_Expecter) DeleteByProject(ctx interface{}, projectID interface{}) *MockTaskRepository_DeleteByProject_Call {
+	return &MockTaskRepository_DeleteByProject_Call{Call: _e.mock.On("DeleteByProject", ctx, projectID)}
+}
+
+func (_c *MockTaskRepository_DeleteByProject_Call) Run(run func(ctx context.Context, projectID primitive.ObjectID)) *MockTaskRepository_DeleteByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteByProject_Call) Return(_a0 error) *MockTaskRepository_DeleteByProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteByProject_Call) RunAndReturn(run func(context.Context, primitive.ObjectID) error) *MockTaskRepository_DeleteByProject_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -245,6 +389,256 @@ func (_c *MockTaskRepository_FindByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// FindStorageKeysByProject provides a mock function with given fields: ctx, projectID
+func (_m *MockTaskRepository) FindStorageKeysByProject(ctx context.Context, projectID primitive.ObjectID) ([]string, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStorageKeysByProject")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) ([]string, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) []string); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_FindStorageKeysByProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStorageKeysByProject'
+type MockTaskRepository_FindStorageKeysByProject_Call struct {
+	*mock.Call
+}
+
+// FindStorageKeysByProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID primitive.ObjectID
+func (_e *MockTaskRepository_Expecter) FindStorageKeysByProject(ctx interface{}, projectID interface{}) *MockTaskRepository_FindStorageKeysByProject_Call {
+	return &MockTaskRepository_FindStorageKeysByProject_Call{Call: _e.mock.On("FindStorageKeysByProject", ctx, projectID)}
+}
+
+func (_c *MockTaskRepository_FindStorageKeysByProject_Call) Run(run func(ctx context.Context, projectID primitive.ObjectID)) *MockTaskRepository_FindStorageKeysByProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_FindStorageKeysByProject_Call) Return(_a0 []string, _a1 error) *MockTaskRepository_FindStorageKeysByProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_FindStorageKeysByProject_Call) RunAndReturn(run func(context.Context, primitive.ObjectID) ([]string, error)) *MockTaskRepository_FindStorageKeysByProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAssigneeFromProject provides a mock function with given fields: ctx, projectID, userID
+func (_m *MockTaskRepository) RemoveAssigneeFromProject(ctx context.Context, projectID primitive.ObjectID, userID primitive.ObjectID) error {
+	ret := _m.Called(ctx, projectID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAssigneeFromProject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, projectID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_RemoveAssigneeFromProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAssigneeFromProject'
+type MockTaskRepository_RemoveAssigneeFromProject_Call struct {
+	*mock.Call
+}
+
+// RemoveAssigneeFromProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID primitive.ObjectID
+//   - userID primitive.ObjectID
+func (_e *MockTaskRepository_Expecter) RemoveAssigneeFromProject(ctx interface{}, projectID interface{}, userID interface{}) *MockTaskRepository_RemoveAssigneeFromProject_Call {
+	return &MockTaskRepository_RemoveAssigneeFromProject_Call{Call: _e.mock.On("RemoveAssigneeFromProject", ctx, projectID, userID)}
+}
+
+func (_c *MockTaskRepository_RemoveAssigneeFromProject_Call) Run(run func(ctx context.Context, projectID primitive.ObjectID, userID primitive.ObjectID)) *MockTaskRepository_RemoveAssigneeFromProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveAssigneeFromProject_Call) Return(_a0 error) *MockTaskRepository_RemoveAssigneeFromProject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveAssigneeFromProject_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, primitive.ObjectID) error) *MockTaskRepository_RemoveAssigneeFromProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAttachment provides a mock function with given fields: ctx, taskID, attachmentID
+func (_m *MockTaskRepository) RemoveAttachment(ctx context.Context, taskID primitive.ObjectID, attachmentID primitive.ObjectID) error {
+	ret := _m.Called(ctx, taskID, attachmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, primitive.ObjectID) error); ok {
+		r0 = rf(ctx, taskID, attachmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_RemoveAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAttachment'
+type MockTaskRepository_RemoveAttachment_Call struct {
+	*mock.Call
+}
+
+// RemoveAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID primitive.ObjectID
+//   - attachmentID primitive.ObjectID
+func (_e *MockTaskRepository_Expecter) RemoveAttachment(ctx interface{}, taskID interface{}, attachmentID interface{}) *MockTaskRepository_RemoveAttachment_Call {
+	return &MockTaskRepository_RemoveAttachment_Call{Call: _e.mock.On("RemoveAttachment", ctx, taskID, attachmentID)}
+}
+
+func (_c *MockTaskRepository_RemoveAttachment_Call) Run(run func(ctx context.Context, taskID primitive.ObjectID, attachmentID primitive.ObjectID)) *MockTaskRepository_RemoveAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveAttachment_Call) Return(_a0 error) *MockTaskRepository_RemoveAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveAttachment_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, primitive.ObjectID) error) *MockTaskRepository_RemoveAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTag provides a mock function with given fields: ctx, name
+func (_m *MockTaskRepository) RemoveTag(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_RemoveTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTag'
+type MockTaskRepository_RemoveTag_Call struct {
+	*mock.Call
+}
+
+// RemoveTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockTaskRepository_Expecter) RemoveTag(ctx interface{}, name interface{}) *MockTaskRepository_RemoveTag_Call {
+	return &MockTaskRepository_RemoveTag_Call{Call: _e.mock.On("RemoveTag", ctx, name)}
+}
+
+func (_c *MockTaskRepository_RemoveTag_Call) Run(run func(ctx context.Context, name string)) *MockTaskRepository_RemoveTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveTag_Call) Return(_a0 error) *MockTaskRepository_RemoveTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_RemoveTag_Call) RunAndReturn(run func(context.Context, string) error) *MockTaskRepository_RemoveTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, oldName, newName
+func (_m *MockTaskRepository) RenameTag(ctx context.Context, oldName string, newName string) error {
+	ret := _m.Called(ctx, oldName, newName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, oldName, newName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockTaskRepository_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldName string
+//   - newName string
+func (_e *MockTaskRepository_Expecter) RenameTag(ctx interface{}, oldName interface{}, newName interface{}) *MockTaskRepository_RenameTag_Call {
+	return &MockTaskRepository_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, oldName, newName)}
+}
+
+func (_c *MockTaskRepository_RenameTag_Call) Run(run func(ctx context.Context, oldName string, newName string)) *MockTaskRepository_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_RenameTag_Call) Return(_a0 error) *MockTaskRepository_RenameTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_RenameTag_Call) RunAndReturn(run func(context.Context, string, string) error) *MockTaskRepository_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, task
 func (_m *MockTaskRepository) Update(ctx context.Context, task *model.Task) error {
 	ret := _m.Called(ctx, task)
@@ -24,9 +24,9 @@ func (_m *MockTaskService) EXPECT() *MockTaskService_Expecter {
 	return &MockTaskService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, req
-func (_m *MockTaskService) Create(ctx context.Context, req dto.CreateTaskRequest) (*model.Task, error) {
-	ret := _m.Called(ctx, req)
+// Create provides a mock function with given fields: ctx, userID, req
+func (_m *MockTaskService) Create(ctx context.Context, userID string, req dto.CreateTaskRequest) (*model.Task, error) {
+	ret := _m.Called(ctx, userID, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -34,19 +34,19 @@ func (_m *MockTaskService) Create(ctx context.Context, req dto.CreateTaskRequest
 
 	var r0 *model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateTaskRequest) (*model.Task, error)); ok {
-		return rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CreateTaskRequest) (*model.Task, error)); ok {
+		return rf(ctx, userID, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateTaskRequest) *model.Task); ok {
-		r0 = rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.CreateTaskRequest) *model.Task); ok {
+		r0 = rf(ctx, userID, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateTaskRequest) error); ok {
-		r1 = rf(ctx, req)
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.CreateTaskRequest) error); ok {
+		r1 = rf(ctx, userID, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,14 +61,15 @@ type MockTaskService_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - req dto.CreateTaskRequest
-func (_e *MockTaskService_Expecter) Create(ctx interface{}, req interface{}) *MockTaskService_Create_Call {
-	return &MockTaskService_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+func (_e *MockTaskService_Expecter) Create(ctx interface{}, userID interface{}, req interface{}) *MockTaskService_Create_Call {
+	return &MockTaskService_Create_Call{Call: _e.mock.On("Create", ctx, userID, req)}
 }
 
-func (_c *MockTaskService_Create_Call) Run(run func(ctx context.Context, req dto.CreateTaskRequest)) *MockTaskService_Create_Call {
+func (_c *MockTaskService_Create_Call) Run(run func(ctx context.Context, userID string, req dto.CreateTaskRequest)) *MockTaskService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.CreateTaskRequest))
+		run(args[0].(context.Context), args[1].(string), args[2].(dto.CreateTaskRequest))
 	})
 	return _c
 }
@@ -78,22 +79,22 @@ func (_c *MockTaskService_Create_Call) Return(_a0 *model.Task, _a1 error) *MockT
 	return _c
 }
 
-func (_c *MockTaskService_Create_Call) RunAndReturn(run func(context.Context, dto.CreateTaskRequest) (*model.Task, error)) *MockTaskService_Create_Call {
+func (_c *MockTaskService_Create_Call) RunAndReturn(run func(context.Context, string, dto.CreateTaskRequest) (*model.Task, error)) *MockTaskService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *MockTaskService) Delete(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *MockTaskService) Delete(ctx context.Context, userID string, id string) error {
+	ret := _m.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -108,14 +109,15 @@ type MockTaskService_Delete_Call struct {
 
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - id string
-func (_e *MockTaskService_Expecter) Delete(ctx interface{}, id interface{}) *MockTaskService_Delete_Call {
-	return &MockTaskService_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *MockTaskService_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *MockTaskService_Delete_Call {
+	return &MockTaskService_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
 }
 
-func (_c *MockTaskService_Delete_Call) Run(run func(ctx context.Context, id string)) *MockTaskService_Delete_Call {
+func (_c *MockTaskService_Delete_Call) Run(run func(ctx context.Context, userID string, id string)) *MockTaskService_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -125,14 +127,14 @@ func (_c *MockTaskService_Delete_Call) Return(_a0 error) *MockTaskService_Delete
 	return _c
 }
 
-func (_c *MockTaskService_Delete_Call) RunAndReturn(run func(context.Context, string) error) *MockTaskService_Delete_Call {
+func (_c *MockTaskService_Delete_Call) RunAndReturn(run func(context.Context, string, string) error) *MockTaskService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *MockTaskService) GetByID(ctx context.Context, id string) (*model.Task, error) {
-	ret := _m.Called(ctx, id)
+// GetByID provides a mock function with given fields: ctx, userID, id
+func (_m *MockTaskService) GetByID(ctx context.Context, userID string, id string) (*model.Task, error) {
+	ret := _m.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -140,19 +142,19 @@ func (_m *MockTaskService) GetByID(ctx context.Context, id string) (*model.Task,
 
 	var r0 *model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Task, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.Task, error)); ok {
+		return rf(ctx, userID, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Task); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Task); ok {
+		r0 = rf(ctx, userID, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -167,14 +169,15 @@ type MockTaskService_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - id string
-func (_e *MockTaskService_Expecter) GetByID(ctx interface{}, id interface{}) *MockTaskService_GetByID_Call {
-	return &MockTaskService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockTaskService_Expecter) GetByID(ctx interface{}, userID interface{}, id interface{}) *MockTaskService_GetByID_Call {
+	return &MockTaskService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userID, id)}
 }
 
-func (_c *MockTaskService_GetByID_Call) Run(run func(ctx context.Context, id string)) *MockTaskService_GetByID_Call {
+func (_c *MockTaskService_GetByID_Call) Run(run func(ctx context.Context, userID string, id string)) *MockTaskService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -184,14 +187,14 @@ func (_c *MockTaskService_GetByID_Call) Return(_a0 *model.Task, _a1 error) *Mock
 	return _c
 }
 
-func (_c *MockTaskService_GetByID_Call) RunAndReturn(run func(context.Context, string) (*model.Task, error)) *MockTaskService_GetByID_Call {
+func (_c *MockTaskService_GetByID_Call) RunAndReturn(run func(context.Context, string, string) (*model.Task, error)) *MockTaskService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function with given fields: ctx, params
-func (_m *MockTaskService) List(ctx context.Context, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error) {
-	ret := _m.Called(ctx, params)
+// List provides a mock function with given fields: ctx, userID, params
+func (_m *MockTaskService) List(ctx context.Context, userID string, params dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error) {
+	ret := _m.Called(ctx, userID, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
@@ -200,25 +203,25 @@ func (_m *MockTaskService) List(ctx context.Context, params dto.TaskQueryParams)
 	var r0 []model.Task
 	var r1 dto.PaginationMeta
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)); ok {
-		return rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)); ok {
+		return rf(ctx, userID, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, dto.TaskQueryParams) []model.Task); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, string, dto.TaskQueryParams) []model.Task); ok {
+		r0 = rf(ctx, userID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, dto.TaskQueryParams) dto.PaginationMeta); ok {
-		r1 = rf(ctx, params)
+	if rf, ok := ret.Get(1).(func(context.Context, string, dto.TaskQueryParams) dto.PaginationMeta); ok {
+		r1 = rf(ctx, userID, params)
 	} else {
 		r1 = ret.Get(1).(dto.PaginationMeta)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, dto.TaskQueryParams) error); ok {
-		r2 = rf(ctx, params)
+	if rf, ok := ret.Get(2).(func(context.Context, string, dto.TaskQueryParams) error); ok {
+		r2 = rf(ctx, userID, params)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -233,14 +236,15 @@ type MockTaskService_List_Call struct {
 
 // List is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - params dto.TaskQueryParams
-func (_e *MockTaskService_Expecter) List(ctx interface{}, params interface{}) *MockTaskService_List_Call {
-	return &MockTaskService_List_Call{Call: _e.mock.On("List", ctx, params)}
+func (_e *MockTaskService_Expecter) List(ctx interface{}, userID interface{}, params interface{}) *MockTaskService_List_Call {
+	return &MockTaskService_List_Call{Call: _e.mock.On("List", ctx, userID, params)}
 }
 
-func (_c *MockTaskService_List_Call) Run(run func(ctx context.Context, params dto.TaskQueryParams)) *MockTaskService_List_Call {
+func (_c *MockTaskService_List_Call) Run(run func(ctx context.Context, userID string, params dto.TaskQueryParams)) *MockTaskService_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(dto.TaskQueryParams))
+		run(args[0].(context.Context), args[1].(string), args[2].(dto.TaskQueryParams))
 	})
 	return _c
 }
@@ -250,14 +254,14 @@ func (_c *MockTaskService_List_Call) Return(_a0 []model.Task, _a1 dto.Pagination
 	return _c
 }
 
-func (_c *MockTaskService_List_Call) RunAndReturn(run func(context.Context, dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)) *MockTaskService_List_Call {
+func (_c *MockTaskService_List_Call) RunAndReturn(run func(context.Context, string, dto.TaskQueryParams) ([]model.Task, dto.PaginationMeta, error)) *MockTaskService_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, id, req
-func (_m *MockTaskService) Update(ctx context.Context, id string, req dto.UpdateTaskRequest) (*model.Task, error) {
-	ret := _m.Called(ctx, id, req)
+// Update provides a mock function with given fields: ctx, userID, id, req
+func (_m *MockTaskService) Update(ctx context.Context, userID string, id string, req dto.UpdateTaskRequest) (*model.Task, error) {
+	ret := _m.Called(ctx, userID, id, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -265,19 +269,19 @@ func (_m *MockTaskService) Update(ctx context.Context, id string, req dto.Update
 
 	var r0 *model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, dto.UpdateTaskRequest) (*model.Task, error)); ok {
-		return rf(ctx, id, req)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.UpdateTaskRequest) (*model.Task, error)); ok {
+		return rf(ctx, userID, id, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, dto.UpdateTaskRequest) *model.Task); ok {
-		r0 = rf(ctx, id, req)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.UpdateTaskRequest) *model.Task); ok {
+		r0 = rf(ctx, userID, id, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, dto.UpdateTaskRequest) error); ok {
-		r1 = rf(ctx, id, req)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, dto.UpdateTaskRequest) error); ok {
+		r1 = rf(ctx, userID, id, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -292,15 +296,16 @@ type MockTaskService_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - id string
 //   - req dto.UpdateTaskRequest
-func (_e *MockTaskService_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *MockTaskService_Update_Call {
-	return &MockTaskService_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+func (_e *MockTaskService_Expecter) Update(ctx interface{}, userID interface{}, id interface{}, req interface{}) *MockTaskService_Update_Call {
+	return &MockTaskService_Update_Call{Call: _e.mock.On("Update", ctx, userID, id, req)}
 }
 
-func (_c *MockTaskService_Update_Call) Run(run func(ctx context.Context, id string, req dto.UpdateTaskRequest)) *MockTaskService_Update_Call {
+func (_c *MockTaskService_Update_Call) Run(run func(ctx context.Context, userID string, id string, req dto.UpdateTaskRequest)) *MockTaskService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(dto.UpdateTaskRequest))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(dto.UpdateTaskRequest))
 	})
 	return _c
 }
@@ -310,7 +315,7 @@ func (_c *MockTaskService_Update_Call) Return(_a0 *model.Task, _a1 error) *MockT
 	return _c
 }
 
-func (_c *MockTaskService_Update_Call) RunAndReturn(run func(context.Context, string, dto.UpdateTaskRequest) (*model.Task, error)) *MockTaskService_Update_Call {
+func (_c *MockTaskService_Update_Call) RunAndReturn(run func(context.Context, string, string, dto.UpdateTaskRequest) (*model.Task, error)) *MockTaskService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,10 +4,11 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	model "github.com/grachmannico95/mileapp-test-be/internal/model"
+	repository "github.com/grachmannico95/mileapp-test-be/internal/repository"
 	mock "github.com/stretchr/testify/mock"
-
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -24,6 +25,64 @@ func (_m *MockUserRepository) EXPECT() *MockUserRepository_Expecter {
 	return &MockUserRepository_Expecter{mock: &_m.Mock}
 }
 
+// ConsumeRecoveryCode provides a mock function with given fields: ctx, id, codeHash
+func (_m *MockUserRepository) ConsumeRecoveryCode(ctx context.Context, id primitive.ObjectID, codeHash string) (bool, error) {
+	ret := _m.Called(ctx, id, codeHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) (bool, error)); ok {
+		return rf(ctx, id, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) bool); ok {
+		r0 = rf(ctx, id, codeHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, string) error); ok {
+		r1 = rf(ctx, id, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_ConsumeRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeRecoveryCode'
+type MockUserRepository_ConsumeRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// ConsumeRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - codeHash string
+func (_e *MockUserRepository_Expecter) ConsumeRecoveryCode(ctx interface{}, id interface{}, codeHash interface{}) *MockUserRepository_ConsumeRecoveryCode_Call {
+	return &MockUserRepository_ConsumeRecoveryCode_Call{Call: _e.mock.On("ConsumeRecoveryCode", ctx, id, codeHash)}
+}
+
+func (_c *MockUserRepository_ConsumeRecoveryCode_Call) Run(run func(ctx context.Context, id primitive.ObjectID, codeHash string)) *MockUserRepository_ConsumeRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_ConsumeRecoveryCode_Call) Return(_a0 bool, _a1 error) *MockUserRepository_ConsumeRecoveryCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_ConsumeRecoveryCode_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, string) (bool, error)) *MockUserRepository_ConsumeRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, user
 func (_m *MockUserRepository) Create(ctx context.Context, user *model.User) error {
 	ret := _m.Called(ctx, user)
@@ -71,6 +130,72 @@ func (_c *MockUserRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Find provides a mock function with given fields: ctx, filters
+func (_m *MockUserRepository) Find(ctx context.Context, filters repository.UserFilters) ([]model.User, int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []model.User
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserFilters) ([]model.User, int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserFilters) []model.User); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UserFilters) int64); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.UserFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockUserRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters repository.UserFilters
+func (_e *MockUserRepository_Expecter) Find(ctx interface{}, filters interface{}) *MockUserRepository_Find_Call {
+	return &MockUserRepository_Find_Call{Call: _e.mock.On("Find", ctx, filters)}
+}
+
+func (_c *MockUserRepository_Find_Call) Run(run func(ctx context.Context, filters repository.UserFilters)) *MockUserRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repository.UserFilters))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_Find_Call) Return(_a0 []model.User, _a1 int64, _a2 error) *MockUserRepository_Find_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserRepository_Find_Call) RunAndReturn(run func(context.Context, repository.UserFilters) ([]model.User, int64, error)) *MockUserRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByEmail provides a mock function with given fields: ctx, email
 func (_m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	ret := _m.Called(ctx, email)
@@ -189,12 +314,389 @@ func (_c *MockUserRepository_FindByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, user
-func (_m *MockUserRepository) Update(ctx context.Context, user *model.User) error {
+// FindByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockUserRepository) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) ([]model.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []primitive.ObjectID) []model.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []primitive.ObjectID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockUserRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []primitive.ObjectID
+func (_e *MockUserRepository_Expecter) FindByIDs(ctx interface{}, ids interface{}) *MockUserRepository_FindByIDs_Call {
+	return &MockUserRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", ctx, ids)}
+}
+
+func (_c *MockUserRepository_FindByIDs_Call) Run(run func(ctx context.Context, ids []primitive.ObjectID)) *MockUserRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_FindByIDs_Call) Return(_a0 []model.User, _a1 error) *MockUserRepository_FindByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_FindByIDs_Call) RunAndReturn(run func(context.Context, []primitive.ObjectID) ([]model.User, error)) *MockUserRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementTokenVersion provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) IncrementTokenVersion(ctx context.Context, id primitive.ObjectID) (int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementTokenVersion")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) (int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID) int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_IncrementTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementTokenVersion'
+type MockUserRepository_IncrementTokenVersion_Call struct {
+	*mock.Call
+}
+
+// IncrementTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+func (_e *MockUserRepository_Expecter) IncrementTokenVersion(ctx interface{}, id interface{}) *MockUserRepository_IncrementTokenVersion_Call {
+	return &MockUserRepository_IncrementTokenVersion_Call{Call: _e.mock.On("IncrementTokenVersion", ctx, id)}
+}
+
+func (_c *MockUserRepository_IncrementTokenVersion_Call) Run(run func(ctx context.Context, id primitive.ObjectID)) *MockUserRepository_IncrementTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_IncrementTokenVersion_Call) Return(_a0 int, _a1 error) *MockUserRepository_IncrementTokenVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_IncrementTokenVersion_Call) RunAndReturn(run func(context.Context, primitive.ObjectID) (int, error)) *MockUserRepository_IncrementTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkEmailVerified provides a mock function with given fields: ctx, id, verifiedAt
+func (_m *MockUserRepository) MarkEmailVerified(ctx context.Context, id primitive.ObjectID, verifiedAt time.Time) error {
+	ret := _m.Called(ctx, id, verifiedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkEmailVerified")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, time.Time) error); ok {
+		r0 = rf(ctx, id, verifiedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_MarkEmailVerified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkEmailVerified'
+type MockUserRepository_MarkEmailVerified_Call struct {
+	*mock.Call
+}
+
+// MarkEmailVerified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - verifiedAt time.Time
+func (_e *MockUserRepository_Expecter) MarkEmailVerified(ctx interface{}, id interface{}, verifiedAt interface{}) *MockUserRepository_MarkEmailVerified_Call {
+	return &MockUserRepository_MarkEmailVerified_Call{Call: _e.mock.On("MarkEmailVerified", ctx, id, verifiedAt)}
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Run(run func(ctx context.Context, id primitive.ObjectID, verifiedAt time.Time)) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) Return(_a0 error) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_MarkEmailVerified_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, time.Time) error) *MockUserRepository_MarkEmailVerified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMFA provides a mock function with given fields: ctx, id, mfa
+func (_m *MockUserRepository) UpdateMFA(ctx context.Context, id primitive.ObjectID, mfa model.MFA) error {
+	ret := _m.Called(ctx, id, mfa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMFA")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, model.MFA) error); ok {
+		r0 = rf(ctx, id, mfa)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMFA'
+type MockUserRepository_UpdateMFA_Call struct {
+	*mock.Call
+}
+
+// UpdateMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - mfa model.MFA
+func (_e *MockUserRepository_Expecter) UpdateMFA(ctx interface{}, id interface{}, mfa interface{}) *MockUserRepository_UpdateMFA_Call {
+	return &MockUserRepository_UpdateMFA_Call{Call: _e.mock.On("UpdateMFA", ctx, id, mfa)}
+}
+
+func (_c *MockUserRepository_UpdateMFA_Call) Run(run func(ctx context.Context, id primitive.ObjectID, mfa model.MFA)) *MockUserRepository_UpdateMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(model.MFA))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateMFA_Call) Return(_a0 error) *MockUserRepository_UpdateMFA_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateMFA_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, model.MFA) error) *MockUserRepository_UpdateMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMFALastUsedStep provides a mock function with given fields: ctx, id, step
+func (_m *MockUserRepository) UpdateMFALastUsedStep(ctx context.Context, id primitive.ObjectID, step int64) (bool, error) {
+	ret := _m.Called(ctx, id, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMFALastUsedStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, int64) (bool, error)); ok {
+		return rf(ctx, id, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, int64) bool); ok {
+		r0 = rf(ctx, id, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, int64) error); ok {
+		r1 = rf(ctx, id, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_UpdateMFALastUsedStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMFALastUsedStep'
+type MockUserRepository_UpdateMFALastUsedStep_Call struct {
+	*mock.Call
+}
+
+// UpdateMFALastUsedStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - step int64
+func (_e *MockUserRepository_Expecter) UpdateMFALastUsedStep(ctx interface{}, id interface{}, step interface{}) *MockUserRepository_UpdateMFALastUsedStep_Call {
+	return &MockUserRepository_UpdateMFALastUsedStep_Call{Call: _e.mock.On("UpdateMFALastUsedStep", ctx, id, step)}
+}
+
+func (_c *MockUserRepository_UpdateMFALastUsedStep_Call) Run(run func(ctx context.Context, id primitive.ObjectID, step int64)) *MockUserRepository_UpdateMFALastUsedStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateMFALastUsedStep_Call) Return(_a0 bool, _a1 error) *MockUserRepository_UpdateMFALastUsedStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateMFALastUsedStep_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, int64) (bool, error)) *MockUserRepository_UpdateMFALastUsedStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePassword provides a mock function with given fields: ctx, id, hash
+func (_m *MockUserRepository) UpdatePassword(ctx context.Context, id primitive.ObjectID, hash string) error {
+	ret := _m.Called(ctx, id, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string) error); ok {
+		r0 = rf(ctx, id, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockUserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - hash string
+func (_e *MockUserRepository_Expecter) UpdatePassword(ctx interface{}, id interface{}, hash interface{}) *MockUserRepository_UpdatePassword_Call {
+	return &MockUserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, hash)}
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, id primitive.ObjectID, hash string)) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) Return(_a0 error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePassword_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, string) error) *MockUserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasswordHash provides a mock function with given fields: ctx, id, currentHash, newHash
+func (_m *MockUserRepository) UpdatePasswordHash(ctx context.Context, id primitive.ObjectID, currentHash string, newHash string) (bool, error) {
+	ret := _m.Called(ctx, id, currentHash, newHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasswordHash")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string, string) (bool, error)); ok {
+		return rf(ctx, id, currentHash, newHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, string, string) bool); ok {
+		r0 = rf(ctx, id, currentHash, newHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, primitive.ObjectID, string, string) error); ok {
+		r1 = rf(ctx, id, currentHash, newHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserRepository_UpdatePasswordHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordHash'
+type MockUserRepository_UpdatePasswordHash_Call struct {
+	*mock.Call
+}
+
+// UpdatePasswordHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - currentHash string
+//   - newHash string
+func (_e *MockUserRepository_Expecter) UpdatePasswordHash(ctx interface{}, id interface{}, currentHash interface{}, newHash interface{}) *MockUserRepository_UpdatePasswordHash_Call {
+	return &MockUserRepository_UpdatePasswordHash_Call{Call: _e.mock.On("UpdatePasswordHash", ctx, id, currentHash, newHash)}
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Run(run func(ctx context.Context, id primitive.ObjectID, currentHash string, newHash string)) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) Return(_a0 bool, _a1 error) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdatePasswordHash_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, string, string) (bool, error)) *MockUserRepository_UpdatePasswordHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function with given fields: ctx, user
+func (_m *MockUserRepository) UpdateProfile(ctx context.Context, user *model.User) error {
 	ret := _m.Called(ctx, user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for UpdateProfile")
 	}
 
 	var r0 error
@@ -207,31 +709,79 @@ func (_m *MockUserRepository) Update(ctx context.Context, user *model.User) erro
 	return r0
 }
 
-// MockUserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockUserRepository_Update_Call struct {
+// MockUserRepository_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockUserRepository_UpdateProfile_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// UpdateProfile is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *model.User
-func (_e *MockUserRepository_Expecter) Update(ctx interface{}, user interface{}) *MockUserRepository_Update_Call {
-	return &MockUserRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
+func (_e *MockUserRepository_Expecter) UpdateProfile(ctx interface{}, user interface{}) *MockUserRepository_UpdateProfile_Call {
+	return &MockUserRepository_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, user)}
 }
 
-func (_c *MockUserRepository_Update_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserRepository_Update_Call {
+func (_c *MockUserRepository_UpdateProfile_Call) Run(run func(ctx context.Context, user *model.User)) *MockUserRepository_UpdateProfile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(*model.User))
 	})
 	return _c
 }
 
-func (_c *MockUserRepository_Update_Call) Return(_a0 error) *MockUserRepository_Update_Call {
+func (_c *MockUserRepository_UpdateProfile_Call) Return(_a0 error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateProfile_Call) RunAndReturn(run func(context.Context, *model.User) error) *MockUserRepository_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, id, role
+func (_m *MockUserRepository) UpdateRole(ctx context.Context, id primitive.ObjectID, role model.Role) error {
+	ret := _m.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, primitive.ObjectID, model.Role) error); ok {
+		r0 = rf(ctx, id, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockUserRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id primitive.ObjectID
+//   - role model.Role
+func (_e *MockUserRepository_Expecter) UpdateRole(ctx interface{}, id interface{}, role interface{}) *MockUserRepository_UpdateRole_Call {
+	return &MockUserRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, role)}
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Run(run func(ctx context.Context, id primitive.ObjectID, role model.Role)) *MockUserRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(primitive.ObjectID), args[2].(model.Role))
+	})
+	return _c
+}
+
+func (_c *MockUserRepository_UpdateRole_Call) Return(_a0 error) *MockUserRepository_UpdateRole_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockUserRepository_Update_Call) RunAndReturn(run func(context.Context, *model.User) error) *MockUserRepository_Update_Call {
+func (_c *MockUserRepository_UpdateRole_Call) RunAndReturn(run func(context.Context, primitive.ObjectID, model.Role) error) *MockUserRepository_UpdateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,91 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_Path(t *testing.T) {
+	root := t.TempDir()
+	store := NewLocalStore(root)
+
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{name: "nested key", key: "tasks/abc/file.png", want: filepath.Join(root, "tasks", "abc", "file.png")},
+		{name: "dot segments inside the root", key: "tasks/../other/file.png", want: filepath.Join(root, "other", "file.png")},
+		{name: "leading dot", key: "./file.png", want: filepath.Join(root, "file.png")},
+		{name: "empty key", key: "", wantErr: true},
+		{name: "parent directory", key: "..", wantErr: true},
+		{name: "escapes the root", key: "../secret", wantErr: true},
+		{name: "escapes after cleaning", key: "tasks/../../secret", wantErr: true},
+		{name: "absolute path", key: "/etc/passwd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			path, err := store.path(tt.key)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, path)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, path)
+		})
+	}
+}
+
+func TestLocalStore_PutOpenDelete(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir())
+	key := "tasks/abc/file.txt"
+
+	// Execute & Assert
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("hello world"), 11, "text/plain"))
+
+	blob, err := store.Open(ctx, key)
+	require.NoError(t, err)
+	_, err = blob.Seek(6, io.SeekStart)
+	require.NoError(t, err)
+	content, err := io.ReadAll(blob)
+	require.NoError(t, err)
+	require.NoError(t, blob.Close())
+	assert.Equal(t, "world", string(content))
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Open(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// deleting a missing blob is not an error
+	assert.NoError(t, store.Delete(ctx, key))
+}
+
+func TestLocalStore_RejectsTraversal(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir())
+
+	// Execute
+	putErr := store.Put(ctx, "../escape.txt", strings.NewReader("x"), 1, "text/plain")
+	_, openErr := store.Open(ctx, "../escape.txt")
+	deleteErr := store.Delete(ctx, "../escape.txt")
+
+	// Assert
+	assert.Error(t, putErr)
+	assert.Error(t, openErr)
+	assert.Error(t, deleteErr)
+}
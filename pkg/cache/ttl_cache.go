@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is a small concurrency-safe in-process cache whose entries expire
+// individually. When full, expired entries are purged first and then an
+// arbitrary entry is evicted.
+type TTLCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	items   map[K]entry[V]
+	maxSize int
+}
+
+func NewTTLCache[K comparable, V any](maxSize int) *TTLCache[K, V] {
+	return &TTLCache[K, V]{
+		items:   make(map[K]entry[V]),
+		maxSize: maxSize,
+	}
+}
+
+func (c *TTLCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	if time.Now().After(item.expiresAt) {
+		delete(c.items, key)
+		var zero V
+		return zero, false
+	}
+
+	return item.value, true
+}
+
+func (c *TTLCache[K, V]) Set(key K, value V, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.items[key]; !exists && len(c.items) >= c.maxSize {
+		c.evict()
+	}
+
+	c.items[key] = entry[V]{value: value, expiresAt: time.Now().Add(ttl)}
+}
+
+func (c *TTLCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.items, key)
+}
+
+func (c *TTLCache[K, V]) evict() {
+	now := time.Now()
+	for key, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, key)
+		}
+	}
+
+	for key := range c.items {
+		if len(c.items) < c.maxSize {
+			return
+		}
+		delete(c.items, key)
+	}
+}
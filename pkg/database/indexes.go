@@ -21,6 +21,14 @@ func SetupIndexes(ctx context.Context, db *mongo.Database) error {
 		return fmt.Errorf("failed to create email index: %w", err)
 	}
 
+	roleIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "role", Value: 1}},
+	}
+
+	if _, err := usersCollection.Indexes().CreateOne(ctx, roleIndex); err != nil {
+		return fmt.Errorf("failed to create role index: %w", err)
+	}
+
 	tasksCollection := db.Collection("tasks")
 
 	statusIndex := mongo.IndexModel{
@@ -55,5 +63,320 @@ func SetupIndexes(ctx context.Context, db *mongo.Database) error {
 		return fmt.Errorf("failed to create created_at index: %w", err)
 	}
 
+	userCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, userCreatedAtIndex); err != nil {
+		return fmt.Errorf("failed to create user_id_created_at index: %w", err)
+	}
+
+	userDueDateIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "due_date", Value: 1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, userDueDateIndex); err != nil {
+		return fmt.Errorf("failed to create user_id_due_date index: %w", err)
+	}
+
+	userPriorityIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "priority", Value: 1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, userPriorityIndex); err != nil {
+		return fmt.Errorf("failed to create user_id_priority index: %w", err)
+	}
+
+	projectCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "project_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, projectCreatedAtIndex); err != nil {
+		return fmt.Errorf("failed to create project_id_created_at index: %w", err)
+	}
+
+	assigneeCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "assignee_ids", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, assigneeCreatedAtIndex); err != nil {
+		return fmt.Errorf("failed to create assignee_ids_created_at index: %w", err)
+	}
+
+	tagsCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "tags", Value: 1}, {Key: "created_at", Value: -1}},
+	}
+
+	if _, err := tasksCollection.Indexes().CreateOne(ctx, tagsCreatedAtIndex); err != nil {
+		return fmt.Errorf("failed to create tags_created_at index: %w", err)
+	}
+
+	invitesCollection := db.Collection("invites")
+
+	inviteCodeIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "code", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := invitesCollection.Indexes().CreateOne(ctx, inviteCodeIndex); err != nil {
+		return fmt.Errorf("failed to create invite code index: %w", err)
+	}
+
+	refreshTokensCollection := db.Collection("refresh_tokens")
+
+	tokenHashIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "token_hash", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := refreshTokensCollection.Indexes().CreateOne(ctx, tokenHashIndex); err != nil {
+		return fmt.Errorf("failed to create refresh token hash index: %w", err)
+	}
+
+	familyIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "family_id", Value: 1}},
+	}
+
+	if _, err := refreshTokensCollection.Indexes().CreateOne(ctx, familyIndex); err != nil {
+		return fmt.Errorf("failed to create refresh token family index: %w", err)
+	}
+
+	refreshExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := refreshTokensCollection.Indexes().CreateOne(ctx, refreshExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create refresh token expiry index: %w", err)
+	}
+
+	revokedTokensCollection := db.Collection("revoked_tokens")
+
+	revokedExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := revokedTokensCollection.Indexes().CreateOne(ctx, revokedExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create revoked token expiry index: %w", err)
+	}
+
+	rateLimitsCollection := db.Collection("rate_limits")
+
+	rateLimitExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := rateLimitsCollection.Indexes().CreateOne(ctx, rateLimitExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create rate limit expiry index: %w", err)
+	}
+
+	loginAttemptsCollection := db.Collection("login_attempts")
+
+	loginAttemptExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := loginAttemptsCollection.Indexes().CreateOne(ctx, loginAttemptExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create login attempt expiry index: %w", err)
+	}
+
+	passwordResetTokensCollection := db.Collection("password_reset_tokens")
+
+	resetTokenHashIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "token_hash", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := passwordResetTokensCollection.Indexes().CreateOne(ctx, resetTokenHashIndex); err != nil {
+		return fmt.Errorf("failed to create password reset token hash index: %w", err)
+	}
+
+	resetTokenUserIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}},
+	}
+
+	if _, err := passwordResetTokensCollection.Indexes().CreateOne(ctx, resetTokenUserIndex); err != nil {
+		return fmt.Errorf("failed to create password reset token user index: %w", err)
+	}
+
+	resetTokenExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := passwordResetTokensCollection.Indexes().CreateOne(ctx, resetTokenExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create password reset token expiry index: %w", err)
+	}
+
+	personalAccessTokensCollection := db.Collection("personal_access_tokens")
+
+	patHashIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "token_hash", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := personalAccessTokensCollection.Indexes().CreateOne(ctx, patHashIndex); err != nil {
+		return fmt.Errorf("failed to create personal access token hash index: %w", err)
+	}
+
+	patUserIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	}
+
+	if _, err := personalAccessTokensCollection.Indexes().CreateOne(ctx, patUserIndex); err != nil {
+		return fmt.Errorf("failed to create personal access token user index: %w", err)
+	}
+
+	sessionsCollection := db.Collection("sessions")
+
+	sessionFamilyIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "family_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := sessionsCollection.Indexes().CreateOne(ctx, sessionFamilyIndex); err != nil {
+		return fmt.Errorf("failed to create session family index: %w", err)
+	}
+
+	sessionUserIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "last_seen_at", Value: -1},
+		},
+	}
+
+	if _, err := sessionsCollection.Indexes().CreateOne(ctx, sessionUserIndex); err != nil {
+		return fmt.Errorf("failed to create session user index: %w", err)
+	}
+
+	sessionExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := sessionsCollection.Indexes().CreateOne(ctx, sessionExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create session expiry index: %w", err)
+	}
+
+	auditLogsCollection := db.Collection("audit_logs")
+
+	auditLogCreatedAtIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: -1}},
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogCreatedAtIndex); err != nil {
+		return fmt.Errorf("failed to create audit log created_at index: %w", err)
+	}
+
+	auditLogActorIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "actor_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogActorIndex); err != nil {
+		return fmt.Errorf("failed to create audit log actor index: %w", err)
+	}
+
+	auditLogImpersonatorIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "impersonator_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+		Options: options.Index().SetSparse(true),
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogImpersonatorIndex); err != nil {
+		return fmt.Errorf("failed to create audit log impersonator index: %w", err)
+	}
+
+	auditLogActionIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "action", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogActionIndex); err != nil {
+		return fmt.Errorf("failed to create audit log action index: %w", err)
+	}
+
+	auditLogTargetIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "target_type", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogTargetIndex); err != nil {
+		return fmt.Errorf("failed to create audit log target index: %w", err)
+	}
+
+	auditLogExpiryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+
+	if _, err := auditLogsCollection.Indexes().CreateOne(ctx, auditLogExpiryIndex); err != nil {
+		return fmt.Errorf("failed to create audit log expiry index: %w", err)
+	}
+
+	projectsCollection := db.Collection("projects")
+
+	projectMemberIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "members.user_id", Value: 1}},
+	}
+
+	if _, err := projectsCollection.Indexes().CreateOne(ctx, projectMemberIndex); err != nil {
+		return fmt.Errorf("failed to create project member index: %w", err)
+	}
+
+	labelsCollection := db.Collection("labels")
+
+	labelNameIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+
+	if _, err := labelsCollection.Indexes().CreateOne(ctx, labelNameIndex); err != nil {
+		return fmt.Errorf("failed to create label name index: %w", err)
+	}
+
+	commentsCollection := db.Collection("comments")
+
+	commentTaskIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "task_id", Value: 1}, {Key: "created_at", Value: 1}},
+	}
+
+	if _, err := commentsCollection.Indexes().CreateOne(ctx, commentTaskIndex); err != nil {
+		return fmt.Errorf("failed to create comment task index: %w", err)
+	}
+
+	commentParentIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "parent_id", Value: 1}, {Key: "created_at", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	}
+
+	if _, err := commentsCollection.Indexes().CreateOne(ctx, commentParentIndex); err != nil {
+		return fmt.Errorf("failed to create comment parent index: %w", err)
+	}
+
+	commentProjectIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "project_id", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	}
+
+	if _, err := commentsCollection.Indexes().CreateOne(ctx, commentProjectIndex); err != nil {
+		return fmt.Errorf("failed to create comment project index: %w", err)
+	}
+
 	return nil
 }
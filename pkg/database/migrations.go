@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// NormalizeUserEmails lower-cases the emails of accounts stored before emails
+// were normalized on write, so that lookups by normalized address find them.
+// An account whose lower-cased email already belongs to another account is left
+// untouched and logged, since merging the two has to be decided by an operator.
+func NormalizeUserEmails(ctx context.Context, db *mongo.Database) error {
+	usersCollection := db.Collection("users")
+
+	filter := bson.M{"$expr": bson.M{"$ne": bson.A{"$email", bson.M{"$toLower": bson.M{"$trim": bson.M{"input": "$email"}}}}}}
+	cursor, err := usersCollection.Find(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to find users with unnormalized emails: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var user struct {
+			ID    primitive.ObjectID `bson:"_id"`
+			Email string             `bson:"email"`
+		}
+		if err := cursor.Decode(&user); err != nil {
+			return fmt.Errorf("failed to decode user: %w", err)
+		}
+
+		email := strings.ToLower(strings.TrimSpace(user.Email))
+
+		count, err := usersCollection.CountDocuments(ctx, bson.M{"email": email, "_id": bson.M{"$ne": user.ID}})
+		if err != nil {
+			return fmt.Errorf("failed to check email collision: %w", err)
+		}
+		if count > 0 {
+			log.Printf("skipping email normalization for user %s: %s is already used by another account", user.ID.Hex(), email)
+			continue
+		}
+
+		if _, err := usersCollection.UpdateByID(ctx, user.ID, bson.M{"$set": bson.M{"email": email}}); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				log.Printf("skipping email normalization for user %s: %s is already used by another account", user.ID.Hex(), email)
+				continue
+			}
+			return fmt.Errorf("failed to normalize email of user %s: %w", user.ID.Hex(), err)
+		}
+	}
+
+	return cursor.Err()
+}
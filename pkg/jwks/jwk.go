@@ -0,0 +1,192 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWK is the public part of a key as published in a JWKS document (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the set. Shared HMAC secrets are never
+// published, so an HMAC key set yields an empty document.
+func (ks *KeySet) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: []JWK{}}
+	if ks.hmac {
+		return set
+	}
+
+	for _, key := range ks.keys {
+		jwk := publicJWK(key.public)
+		jwk.KeyID = key.ID
+		jwk.Use = "sig"
+		jwk.Algorithm = key.Method.Alg()
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	// keep the output stable between requests
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+
+	return set
+}
+
+func publicJWK(publicKey crypto.PublicKey) JWK {
+	switch k := publicKey.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			KeyType: "RSA",
+			N:       base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{
+			KeyType: "OKP",
+			Curve:   "Ed25519",
+			X:       base64.RawURLEncoding.EncodeToString(k),
+		}
+	default:
+		return JWK{}
+	}
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint, which serves as the kid.
+func thumbprint(publicKey crypto.PublicKey) (string, error) {
+	jwk := publicJWK(publicKey)
+
+	// members in lexicographic order, as required by the RFC
+	var canonical []byte
+	var err error
+	switch jwk.KeyType {
+	case "RSA":
+		canonical, err = json.Marshal(struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.KeyType, jwk.N})
+	case "OKP":
+		canonical, err = json.Marshal(struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Curve, jwk.KeyType, jwk.X})
+	default:
+		return "", fmt.Errorf("unsupported key type %T", publicKey)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// NewVerificationKeySet builds a key set from a JWKS document published by
+// someone else, e.g. an identity provider. The published kids are kept as they
+// are and the set can only verify tokens. Keys that are not meant for
+// signatures or use an unsupported type are skipped.
+func NewVerificationKeySet(set JSONWebKeySet) (*KeySet, error) {
+	ks := &KeySet{keys: map[string]*Key{}}
+
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := parseJWK(jwk)
+		if err != nil {
+			continue
+		}
+
+		if jwk.Algorithm != "" && jwk.Algorithm != key.Method.Alg() {
+			continue
+		}
+
+		ks.keys[jwk.KeyID] = key
+	}
+
+	if len(ks.keys) == 0 {
+		return nil, errors.New("jwks contains no usable signing keys")
+	}
+
+	return ks, nil
+}
+
+func parseJWK(jwk JWK) (*Key, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		publicKey := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if publicKey.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("rsa keys must be at least %d bits", minRSAKeyBits)
+		}
+		return &Key{ID: jwk.KeyID, Method: jwt.SigningMethodRS256, public: publicKey}, nil
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return &Key{ID: jwk.KeyID, Method: jwt.SigningMethodEdDSA, public: ed25519.PublicKey(x)}, nil
+	case "EC":
+		if jwk.Curve != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		publicKey := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, errors.New("invalid P-256 key")
+		}
+		return &Key{ID: jwk.KeyID, Method: jwt.SigningMethodES256, public: publicKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", jwk.KeyType)
+	}
+}
@@ -0,0 +1,165 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSAKeyBits = 2048
+
+// Key is a single JWT key. Signing keys carry the private half; keys that are
+// only kept around to verify tokens issued before a rotation do not.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	private crypto.PrivateKey
+	public  crypto.PublicKey
+}
+
+// KeySet signs tokens with one key and verifies them against every key it
+// knows, selected by the "kid" header. Rotating keys means adding the new
+// private key as signing key and keeping the old public key for verification
+// until the tokens it signed have expired.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	hmac    bool
+}
+
+// NewHMACKeySet returns a key set that signs and verifies with a shared
+// HS256 secret. Tokens carry no kid and the set publishes no JWKS.
+func NewHMACKeySet(secret string) *KeySet {
+	key := &Key{
+		Method:  jwt.SigningMethodHS256,
+		private: []byte(secret),
+		public:  []byte(secret),
+	}
+
+	return &KeySet{
+		signing: key,
+		keys:    map[string]*Key{"": key},
+		hmac:    true,
+	}
+}
+
+// NewKeySet builds an asymmetric key set from a signing key and any number of
+// additional verification keys. Key IDs are derived from the public key (RFC
+// 7638 thumbprints), so the same key always gets the same kid.
+func NewKeySet(signingKey crypto.PrivateKey, verificationKeys ...crypto.PublicKey) (*KeySet, error) {
+	signer, ok := signingKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("signing key does not support signing")
+	}
+
+	signing, err := newKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	signing.private = signingKey
+
+	ks := &KeySet{
+		signing: signing,
+		keys:    map[string]*Key{signing.ID: signing},
+	}
+
+	for _, publicKey := range verificationKeys {
+		key, err := newKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := ks.keys[key.ID]; !exists {
+			ks.keys[key.ID] = key
+		}
+	}
+
+	return ks, nil
+}
+
+func newKey(publicKey crypto.PublicKey) (*Key, error) {
+	var method jwt.SigningMethod
+
+	switch k := publicKey.(type) {
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("rsa keys must be at least %d bits", minRSAKeyBits)
+		}
+		method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, expected RSA or Ed25519", publicKey)
+	}
+
+	kid, err := thumbprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		ID:     kid,
+		Method: method,
+		public: publicKey,
+	}, nil
+}
+
+// Sign signs claims with the signing key and sets the kid header.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if ks.signing == nil {
+		return "", errors.New("key set has no signing key")
+	}
+
+	token := jwt.NewWithClaims(ks.signing.Method, claims)
+	if ks.signing.ID != "" {
+		token.Header["kid"] = ks.signing.ID
+	}
+
+	return token.SignedString(ks.signing.private)
+}
+
+// Keyfunc resolves the verification key for a token being parsed. The token's
+// algorithm has to match the one the key was made for, which rules out
+// algorithm confusion between keys.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := ks.keys[kid]
+	if !ok && kid == "" && len(ks.keys) == 1 {
+		// a set with a single key does not need the token to name it
+		for _, only := range ks.keys {
+			key, ok = only, true
+		}
+	}
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+
+	return key.public, nil
+}
+
+// HasKey reports whether the set contains a key with the given kid.
+func (ks *KeySet) HasKey(kid string) bool {
+	_, ok := ks.keys[kid]
+	return ok
+}
+
+// ValidMethods lists the algorithms accepted by this key set.
+func (ks *KeySet) ValidMethods() []string {
+	seen := map[string]bool{}
+	var methods []string
+	for _, key := range ks.keys {
+		if alg := key.Method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
@@ -0,0 +1,294 @@
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEd25519Key(t *testing.T) ed25519.PrivateKey {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return privateKey
+}
+
+func parseWith(ks *KeySet, token string) (*jwt.Token, error) {
+	return jwt.Parse(token, ks.Keyfunc, jwt.WithValidMethods(ks.ValidMethods()))
+}
+
+func TestThumbprint_RFC8037Vector(t *testing.T) {
+	// Test data
+	x, err := base64.RawURLEncoding.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	require.NoError(t, err)
+
+	// Execute
+	kid, err := thumbprint(ed25519.PublicKey(x))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", kid)
+}
+
+func TestNewKeySet_SignAndVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     interface{}
+		wantAlg string
+	}{
+		{name: "ed25519", key: newEd25519Key(t), wantAlg: "EdDSA"},
+		{name: "rsa", key: rsaKey, wantAlg: "RS256"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ks, err := NewKeySet(tt.key)
+			require.NoError(t, err)
+
+			// Execute
+			signed, err := ks.Sign(jwt.MapClaims{"sub": "user"})
+			require.NoError(t, err)
+			token, err := parseWith(ks, signed)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.True(t, token.Valid)
+			assert.Equal(t, tt.wantAlg, token.Method.Alg())
+			assert.True(t, ks.HasKey(token.Header["kid"].(string)))
+		})
+	}
+}
+
+func TestNewKeySet_RejectsUnsupportedKeys(t *testing.T) {
+	weakRSA, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		signing interface{}
+		verify  interface{}
+	}{
+		{name: "short rsa signing key", signing: weakRSA},
+		{name: "ecdsa signing key", signing: ecKey},
+		{name: "not a signer", signing: "secret"},
+		{name: "short rsa verification key", signing: newEd25519Key(t), verify: &weakRSA.PublicKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			var ks *KeySet
+			var err error
+			if tt.verify != nil {
+				ks, err = NewKeySet(tt.signing, tt.verify)
+			} else {
+				ks, err = NewKeySet(tt.signing)
+			}
+
+			// Assert
+			assert.Error(t, err)
+			assert.Nil(t, ks)
+		})
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	// Setup
+	oldKey := newEd25519Key(t)
+	newKey := newEd25519Key(t)
+
+	oldSet, err := NewKeySet(oldKey)
+	require.NoError(t, err)
+	rotated, err := NewKeySet(newKey, oldKey.Public())
+	require.NoError(t, err)
+	withoutOld, err := NewKeySet(newKey)
+	require.NoError(t, err)
+
+	oldToken, err := oldSet.Sign(jwt.MapClaims{"sub": "user"})
+	require.NoError(t, err)
+
+	// Execute
+	_, rotatedErr := parseWith(rotated, oldToken)
+	_, droppedErr := parseWith(withoutOld, oldToken)
+
+	// Assert
+	assert.NoError(t, rotatedErr)
+	assert.Error(t, droppedErr)
+	assert.Len(t, rotated.JWKS().Keys, 2)
+}
+
+func TestKeySet_Keyfunc(t *testing.T) {
+	// Setup
+	ks, err := NewKeySet(newEd25519Key(t))
+	require.NoError(t, err)
+	kid := ks.signing.ID
+
+	tests := []struct {
+		name    string
+		method  jwt.SigningMethod
+		kid     interface{}
+		wantErr string
+	}{
+		{name: "known kid", method: jwt.SigningMethodEdDSA, kid: kid},
+		{name: "single key without kid", method: jwt.SigningMethodEdDSA},
+		{name: "unknown kid", method: jwt.SigningMethodEdDSA, kid: "other", wantErr: "unknown signing key"},
+		{name: "algorithm confusion", method: jwt.SigningMethodHS256, kid: kid, wantErr: "unexpected signing method"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test data
+			token := jwt.New(tt.method)
+			if tt.kid != nil {
+				token.Header["kid"] = tt.kid
+			}
+
+			// Execute
+			key, err := ks.Keyfunc(token)
+
+			// Assert
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, key)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, ks.signing.public, key)
+			}
+		})
+	}
+}
+
+func TestNewHMACKeySet(t *testing.T) {
+	// Setup
+	ks := NewHMACKeySet("test-secret")
+
+	// Execute
+	signed, err := ks.Sign(jwt.MapClaims{"sub": "user"})
+	require.NoError(t, err)
+	token, parseErr := parseWith(ks, signed)
+	_, wrongSecretErr := parseWith(NewHMACKeySet("other-secret"), signed)
+
+	// Assert
+	assert.NoError(t, parseErr)
+	assert.NotContains(t, token.Header, "kid")
+	assert.Error(t, wrongSecretErr)
+	assert.Empty(t, ks.JWKS().Keys)
+}
+
+func TestNewVerificationKeySet(t *testing.T) {
+	// Setup
+	signingKey := newEd25519Key(t)
+	ks, err := NewKeySet(signingKey)
+	require.NoError(t, err)
+	published := ks.JWKS()
+	require.Len(t, published.Keys, 1)
+	jwk := published.Keys[0]
+
+	encryptionKey := jwk
+	encryptionKey.Use = "enc"
+	wrongAlg := jwk
+	wrongAlg.Algorithm = "RS256"
+	badCurve := jwk
+	badCurve.Curve = "X25519"
+
+	tests := []struct {
+		name    string
+		keys    []JWK
+		wantErr bool
+	}{
+		{name: "published signing key", keys: []JWK{jwk}},
+		{name: "encryption key is skipped", keys: []JWK{encryptionKey}, wantErr: true},
+		{name: "mismatched algorithm is skipped", keys: []JWK{wrongAlg}, wantErr: true},
+		{name: "unsupported curve is skipped", keys: []JWK{badCurve}, wantErr: true},
+		{name: "unusable keys next to a usable one", keys: []JWK{encryptionKey, badCurve, jwk}},
+		{name: "empty set", keys: nil, wantErr: true},
+	}
+
+	signed, err := ks.Sign(jwt.MapClaims{"sub": "user"})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			verifier, err := NewVerificationKeySet(JSONWebKeySet{Keys: tt.keys})
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			_, err = parseWith(verifier, signed)
+			assert.NoError(t, err)
+			_, err = verifier.Sign(jwt.MapClaims{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	// Setup
+	dir := t.TempDir()
+	writePEM := func(name, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+		return path
+	}
+
+	signingKey := newEd25519Key(t)
+	signingDER, err := x509.MarshalPKCS8PrivateKey(signingKey)
+	require.NoError(t, err)
+	oldKey := newEd25519Key(t)
+	oldDER, err := x509.MarshalPKIXPublicKey(oldKey.Public())
+	require.NoError(t, err)
+
+	privatePath := writePEM("signing.pem", "PRIVATE KEY", signingDER)
+	publicPath := writePEM("old.pub.pem", "PUBLIC KEY", oldDER)
+	certPath := writePEM("cert.pem", "CERTIFICATE", []byte("not a key"))
+
+	tests := []struct {
+		name         string
+		privateKey   string
+		verification []string
+		wantKeys     int
+		wantErr      bool
+	}{
+		{name: "signing key only", privateKey: privatePath, wantKeys: 1},
+		{name: "with public verification key", privateKey: privatePath, verification: []string{publicPath}, wantKeys: 2},
+		{name: "private key as verification key", privateKey: privatePath, verification: []string{privatePath}, wantKeys: 1},
+		{name: "missing file", privateKey: filepath.Join(dir, "missing.pem"), wantErr: true},
+		{name: "unsupported PEM block", privateKey: certPath, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			ks, err := LoadKeySet(tt.privateKey, tt.verification)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, ks.JWKS().Keys, tt.wantKeys)
+		})
+	}
+}
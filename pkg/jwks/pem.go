@@ -0,0 +1,94 @@
+package jwks
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// LoadKeySet reads a PEM encoded private signing key and optional PEM encoded
+// verification keys (public keys, or private keys whose public half is used).
+func LoadKeySet(privateKeyFile string, verificationKeyFiles []string) (*KeySet, error) {
+	signingKey, err := loadPrivateKey(privateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var verificationKeys []crypto.PublicKey
+	for _, file := range verificationKeyFiles {
+		publicKey, err := loadPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		verificationKeys = append(verificationKeys, publicKey)
+	}
+
+	return NewKeySet(signingKey, verificationKeys...)
+}
+
+func loadPrivateKey(file string) (crypto.PrivateKey, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key %s: %w", file, err)
+		}
+		return key, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key %s: %w", file, err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, file)
+	}
+}
+
+func loadPublicKey(file string) (crypto.PublicKey, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type != "PUBLIC KEY" {
+		privateKey, err := loadPrivateKey(file)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := privateKey.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported key in %s", file)
+		}
+		return signer.Public(), nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %s: %w", file, err)
+	}
+
+	return key, nil
+}
+
+func readPEM(file string) (*pem.Block, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found in " + file)
+	}
+
+	return block, nil
+}
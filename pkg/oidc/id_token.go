@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// IDTokenClaims are the ID token claims the login flow relies on.
+type IDTokenClaims struct {
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	AuthorizedParty   string `json:"azp"`
+	PreferredUsername string `json:"preferred_username"`
+	jwt.RegisteredClaims
+}
+
+// VerifyIDToken checks the signature of an ID token against the provider's
+// keys and validates issuer, audience, expiry and nonce as described in
+// OpenID Connect Core 1.0 section 3.1.3.7.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	discovery, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// look at the header first so the right key set can be fetched
+	unverified, _, err := jwt.NewParser().ParseUnverified(rawIDToken, &IDTokenClaims{})
+	if err != nil {
+		return nil, errors.New("malformed id token")
+	}
+	kid, _ := unverified.Header["kid"].(string)
+
+	keySet, err := p.keys(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(keySet.ValidMethods()),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+
+	claims := &IDTokenClaims{}
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, keySet.Keyfunc); err != nil {
+		return nil, err
+	}
+
+	// with several audiences the token has to name us as the authorized party
+	if (len(claims.Audience) > 1 || claims.AuthorizedParty != "") && claims.AuthorizedParty != p.config.ClientID {
+		return nil, errors.New("id token was issued to another client")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("id token nonce mismatch")
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,90 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// hashPrefixLength matches the Pwned Passwords range API: the first five hex
+// characters of the SHA-1 select a bucket, the remaining 35 are looked up in it.
+const hashPrefixLength = 5
+
+// BreachedList is an offline set of compromised passwords, stored as SHA-1
+// hashes bucketed by hash prefix the same way the k-anonymity range API of
+// Pwned Passwords is, so plaintext passwords are never held in memory.
+type BreachedList struct {
+	buckets map[string][]string
+}
+
+// LoadBreachedList reads a list of upper or lower case SHA-1 hashes, one per
+// line and optionally followed by ":<count>" as in the Pwned Passwords
+// downloads. Empty lines and lines starting with # are skipped.
+func LoadBreachedList(path string) (*BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ReadBreachedList(file)
+}
+
+func ReadBreachedList(r io.Reader) (*BreachedList, error) {
+	list := &BreachedList{buckets: map[string][]string{}}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		hash, _, _ := strings.Cut(line, ":")
+		hash = strings.ToUpper(hash)
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("line %d: expected a SHA-1 hash", lineNumber)
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, fmt.Errorf("line %d: expected a SHA-1 hash", lineNumber)
+		}
+
+		prefix := hash[:hashPrefixLength]
+		list.buckets[prefix] = append(list.buckets[prefix], hash[hashPrefixLength:])
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, suffixes := range list.buckets {
+		sort.Strings(suffixes)
+	}
+
+	return list, nil
+}
+
+// Contains reports whether password is on the list. A nil list contains
+// nothing.
+func (l *BreachedList) Contains(password string) bool {
+	if l == nil {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes := l.buckets[hash[:hashPrefixLength]]
+	suffix := hash[hashPrefixLength:]
+
+	i := sort.SearchStrings(suffixes, suffix)
+	return i < len(suffixes) && suffixes[i] == suffix
+}
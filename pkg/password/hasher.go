@@ -0,0 +1,52 @@
+package password
+
+// Hasher hashes passwords into self-describing strings and verifies passwords
+// against hashes it produced.
+type Hasher interface {
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	// Supports reports whether encoded was produced by this kind of hasher.
+	Supports(encoded string) bool
+	// NeedsRehash reports whether encoded was produced with parameters other
+	// than the ones the hasher is currently configured with.
+	NeedsRehash(encoded string) bool
+}
+
+// Manager hashes new passwords with the current hasher and verifies stored
+// hashes with whichever known hasher produced them, so the algorithm or its
+// cost can change without invalidating existing passwords.
+type Manager struct {
+	current Hasher
+	hashers []Hasher
+}
+
+func NewManager(current Hasher, legacy ...Hasher) *Manager {
+	return &Manager{
+		current: current,
+		hashers: append([]Hasher{current}, legacy...),
+	}
+}
+
+func (m *Manager) Hash(password string) (string, error) {
+	return m.current.Hash(password)
+}
+
+// Verify checks password against encoded. needsRehash is only meaningful when
+// the password matched and tells the caller to store a fresh hash produced by
+// Hash, because encoded uses another algorithm or outdated parameters.
+func (m *Manager) Verify(password, encoded string) (match bool, needsRehash bool) {
+	for _, hasher := range m.hashers {
+		if !hasher.Supports(encoded) {
+			continue
+		}
+
+		match, err := hasher.Verify(password, encoded)
+		if err != nil || !match {
+			return false, false
+		}
+
+		return true, hasher != m.current || hasher.NeedsRehash(encoded)
+	}
+
+	return false, false
+}
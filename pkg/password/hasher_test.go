@@ -0,0 +1,209 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// cheap parameters keep the tests fast; they are never used outside tests
+var testArgon2idParams = Argon2idParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestArgon2idHasher_HashAndVerify(t *testing.T) {
+	// Setup
+	hasher := NewArgon2idHasher(testArgon2idParams)
+
+	// Execute
+	encoded, err := hasher.Hash("correct horse")
+	require.NoError(t, err)
+	other, err := hasher.Hash("correct horse")
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, strings.HasPrefix(encoded, "$argon2id$v=19$m=8192,t=1,p=1$"))
+	assert.NotEqual(t, encoded, other, "every hash uses a fresh salt")
+	assert.True(t, hasher.Supports(encoded))
+	assert.False(t, hasher.NeedsRehash(encoded))
+
+	match, err := hasher.Verify("correct horse", encoded)
+	assert.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = hasher.Verify("wrong horse", encoded)
+	assert.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestNewArgon2idHasher_Defaults(t *testing.T) {
+	// Execute
+	hasher := NewArgon2idHasher(Argon2idParams{Iterations: 1})
+
+	// Assert
+	assert.Equal(t, DefaultArgon2idParams.Memory, hasher.params.Memory)
+	assert.Equal(t, uint32(1), hasher.params.Iterations)
+	assert.Equal(t, DefaultArgon2idParams.Parallelism, hasher.params.Parallelism)
+	assert.Equal(t, DefaultArgon2idParams.SaltLength, hasher.params.SaltLength)
+	assert.Equal(t, DefaultArgon2idParams.KeyLength, hasher.params.KeyLength)
+}
+
+func TestArgon2idHasher_NeedsRehash(t *testing.T) {
+	encoded, err := NewArgon2idHasher(testArgon2idParams).Hash("password")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params Argon2idParams
+		want   bool
+	}{
+		{name: "same parameters", params: testArgon2idParams, want: false},
+		{name: "more memory", params: Argon2idParams{Memory: 16 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}, want: true},
+		{name: "more iterations", params: Argon2idParams{Memory: 8 * 1024, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}, want: true},
+		{name: "more parallelism", params: Argon2idParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32}, want: true},
+		{name: "longer salt", params: Argon2idParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 32, KeyLength: 32}, want: true},
+		{name: "longer key", params: Argon2idParams{Memory: 8 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 64}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			got := NewArgon2idHasher(tt.params).NeedsRehash(encoded)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestArgon2idHasher_Verify_InvalidHashes(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2idParams)
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{name: "empty", encoded: ""},
+		{name: "bcrypt hash", encoded: "$2a$10$abcdefghijklmnopqrstuu"},
+		{name: "missing parts", encoded: "$argon2id$v=19$m=8192,t=1,p=1$c2FsdA"},
+		{name: "wrong version", encoded: "$argon2id$v=16$m=8192,t=1,p=1$c2FsdHNhbHRzYWx0$aGFzaA"},
+		{name: "bad parameters", encoded: "$argon2id$v=19$m=x,t=1,p=1$c2FsdHNhbHRzYWx0$aGFzaA"},
+		{name: "zero memory", encoded: "$argon2id$v=19$m=0,t=1,p=1$c2FsdHNhbHRzYWx0$aGFzaA"},
+		{name: "bad salt encoding", encoded: "$argon2id$v=19$m=8192,t=1,p=1$!!!$aGFzaA"},
+		{name: "empty key", encoded: "$argon2id$v=19$m=8192,t=1,p=1$c2FsdHNhbHRzYWx0$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			match, err := hasher.Verify("password", tt.encoded)
+
+			// Assert
+			assert.ErrorIs(t, err, errInvalidArgon2idHash)
+			assert.False(t, match)
+			assert.True(t, hasher.NeedsRehash(tt.encoded))
+		})
+	}
+}
+
+func TestBcryptHasher(t *testing.T) {
+	// Setup
+	hasher := NewBcryptHasher(bcrypt.MinCost)
+
+	// Execute
+	encoded, err := hasher.Hash("password")
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, hasher.Supports(encoded))
+	assert.False(t, hasher.NeedsRehash(encoded))
+	assert.True(t, NewBcryptHasher(bcrypt.MinCost+1).NeedsRehash(encoded))
+
+	match, err := hasher.Verify("password", encoded)
+	assert.NoError(t, err)
+	assert.True(t, match)
+
+	match, err = hasher.Verify("wrong", encoded)
+	assert.NoError(t, err)
+	assert.False(t, match)
+
+	match, err = hasher.Verify(strings.Repeat("a", 73), encoded)
+	assert.NoError(t, err)
+	assert.False(t, match)
+}
+
+func TestNewBcryptHasher_CostOutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		cost int
+		want int
+	}{
+		{name: "zero", cost: 0, want: DefaultBcryptCost},
+		{name: "below minimum", cost: bcrypt.MinCost - 1, want: DefaultBcryptCost},
+		{name: "above maximum", cost: bcrypt.MaxCost + 1, want: DefaultBcryptCost},
+		{name: "in range", cost: 10, want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			hasher := NewBcryptHasher(tt.cost)
+
+			// Assert
+			assert.Equal(t, tt.want, hasher.cost)
+		})
+	}
+}
+
+func TestManager_Verify(t *testing.T) {
+	// Setup
+	argon := NewArgon2idHasher(testArgon2idParams)
+	bcryptHasher := NewBcryptHasher(bcrypt.MinCost)
+	manager := NewManager(argon, bcryptHasher)
+
+	currentHash, err := argon.Hash("password")
+	require.NoError(t, err)
+	legacyHash, err := bcryptHasher.Hash("password")
+	require.NoError(t, err)
+	weakHash, err := NewArgon2idHasher(Argon2idParams{Memory: 4 * 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}).Hash("password")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		password        string
+		encoded         string
+		wantMatch       bool
+		wantNeedsRehash bool
+	}{
+		{name: "current hash", password: "password", encoded: currentHash, wantMatch: true, wantNeedsRehash: false},
+		{name: "legacy bcrypt hash", password: "password", encoded: legacyHash, wantMatch: true, wantNeedsRehash: true},
+		{name: "outdated argon2id parameters", password: "password", encoded: weakHash, wantMatch: true, wantNeedsRehash: true},
+		{name: "wrong password on legacy hash", password: "wrong", encoded: legacyHash, wantMatch: false, wantNeedsRehash: false},
+		{name: "wrong password on current hash", password: "wrong", encoded: currentHash, wantMatch: false, wantNeedsRehash: false},
+		{name: "unknown format", password: "password", encoded: "plaintext", wantMatch: false, wantNeedsRehash: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			match, needsRehash := manager.Verify(tt.password, tt.encoded)
+
+			// Assert
+			assert.Equal(t, tt.wantMatch, match)
+			assert.Equal(t, tt.wantNeedsRehash, needsRehash)
+		})
+	}
+}
+
+func TestManager_Hash_UsesCurrentHasher(t *testing.T) {
+	// Setup
+	manager := NewManager(NewArgon2idHasher(testArgon2idParams), NewBcryptHasher(bcrypt.MinCost))
+
+	// Execute
+	encoded, err := manager.Hash("password")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encoded, argon2idPrefix))
+}
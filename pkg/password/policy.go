@@ -0,0 +1,112 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Policy describes what a new password has to look like. Lengths are counted
+// in characters, not bytes.
+type Policy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	DisallowEmail bool
+	// Breached is consulted last and only when every other rule passed, so
+	// obviously weak passwords never reach the list.
+	Breached *BreachedList
+}
+
+// Violation is a single policy rule a password failed.
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// PolicyError lists every rule a password failed.
+type PolicyError struct {
+	Violations []Violation
+}
+
+func (e *PolicyError) Error() string {
+	return "password does not meet the policy requirements"
+}
+
+// Check validates password against the policy. email is the account's address
+// and may be empty when it is not known. It returns a *PolicyError or nil.
+func (p *Policy) Check(password, email string) error {
+	var violations []Violation
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		violations = append(violations, Violation{Rule: "min_length", Message: fmt.Sprintf("password must be at least %d characters", p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, Violation{Rule: "max_length", Message: fmt.Sprintf("password must not exceed %d characters", p.MaxLength)})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUpper && !hasUpper {
+		violations = append(violations, Violation{Rule: "uppercase", Message: "password must contain at least one uppercase letter"})
+	}
+	if p.RequireLower && !hasLower {
+		violations = append(violations, Violation{Rule: "lowercase", Message: "password must contain at least one lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{Rule: "digit", Message: "password must contain at least one digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, Violation{Rule: "symbol", Message: "password must contain at least one symbol"})
+	}
+
+	if p.DisallowEmail && containsEmail(password, email) {
+		violations = append(violations, Violation{Rule: "contains_email", Message: "password must not contain the email address"})
+	}
+
+	if len(violations) == 0 && p.Breached.Contains(password) {
+		violations = append(violations, Violation{Rule: "breached", Message: "password has appeared in a data breach, please choose another one"})
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+// containsEmail reports whether password contains the email address or its
+// local part. Very short local parts are ignored, they would reject too many
+// unrelated passwords.
+func containsEmail(password, email string) bool {
+	if email == "" {
+		return false
+	}
+
+	password = strings.ToLower(password)
+	email = strings.ToLower(email)
+
+	if strings.Contains(password, email) {
+		return true
+	}
+
+	localPart, _, found := strings.Cut(email, "@")
+	return found && len(localPart) >= 3 && strings.Contains(password, localPart)
+}
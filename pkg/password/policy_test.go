@@ -0,0 +1,162 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SHA-1 hashes of "password" and "123456"
+const (
+	passwordSHA1 = "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8"
+	numbersSHA1  = "7C4A8D09CA3762AF61E59520943DC26494F8941B"
+)
+
+func violationRules(err error) []string {
+	var policyErr *PolicyError
+	if !errors.As(err, &policyErr) {
+		return nil
+	}
+
+	rules := make([]string, 0, len(policyErr.Violations))
+	for _, violation := range policyErr.Violations {
+		rules = append(rules, violation.Rule)
+	}
+	return rules
+}
+
+func TestPolicy_Check(t *testing.T) {
+	breached, err := ReadBreachedList(strings.NewReader(passwordSHA1 + "\n"))
+	require.NoError(t, err)
+
+	strict := &Policy{
+		MinLength:     8,
+		MaxLength:     16,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		DisallowEmail: true,
+	}
+
+	tests := []struct {
+		name      string
+		policy    *Policy
+		password  string
+		email     string
+		wantRules []string
+	}{
+		{name: "meets every rule", policy: strict, password: "Str0ng!pass", email: "jane@example.com"},
+		{name: "too short", policy: strict, password: "S0!a", wantRules: []string{"min_length"}},
+		{name: "too long", policy: strict, password: "Str0ng!password-too-long", wantRules: []string{"max_length"}},
+		{name: "length counts characters not bytes", policy: &Policy{MinLength: 4, MaxLength: 4}, password: "äöüß"},
+		{name: "missing upper case", policy: strict, password: "str0ng!pass", wantRules: []string{"uppercase"}},
+		{name: "missing lower case", policy: strict, password: "STR0NG!PASS", wantRules: []string{"lowercase"}},
+		{name: "missing digit", policy: strict, password: "Strong!pass", wantRules: []string{"digit"}},
+		{name: "missing symbol", policy: strict, password: "Str0ngpass", wantRules: []string{"symbol"}},
+		{name: "space counts as symbol", policy: strict, password: "Str0ng pass"},
+		{name: "several violations at once", policy: strict, password: "weak", wantRules: []string{"min_length", "uppercase", "digit", "symbol"}},
+		{name: "contains email", policy: strict, password: "Jane@Example.com1", email: "jane@example.com", wantRules: []string{"max_length", "contains_email"}},
+		{name: "contains local part", policy: strict, password: "Xjane!123", email: "jane@example.com", wantRules: []string{"contains_email"}},
+		{name: "short local part is ignored", policy: strict, password: "Xjo!12345", email: "jo@example.com"},
+		{name: "email check disabled", policy: &Policy{}, password: "jane@example.com", email: "jane@example.com"},
+		{name: "unknown email", policy: strict, password: "Xjane!123"},
+		{name: "breached password", policy: &Policy{Breached: breached}, password: "password", wantRules: []string{"breached"}},
+		{name: "breached list skipped when another rule fails", policy: &Policy{MinLength: 10, Breached: breached}, password: "password", wantRules: []string{"min_length"}},
+		{name: "nil breached list", policy: &Policy{}, password: "password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			err := tt.policy.Check(tt.password, tt.email)
+
+			// Assert
+			if len(tt.wantRules) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, "password does not meet the policy requirements")
+			assert.Equal(t, tt.wantRules, violationRules(err))
+		})
+	}
+}
+
+func TestReadBreachedList(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantContains []string
+		wantMissing  []string
+		wantErr      string
+	}{
+		{
+			name:         "upper case hashes",
+			input:        passwordSHA1 + "\n" + numbersSHA1 + "\n",
+			wantContains: []string{"password", "123456"},
+			wantMissing:  []string{"Password", "1234567"},
+		},
+		{
+			name:         "lower case hashes with counts",
+			input:        strings.ToLower(passwordSHA1) + ":3861493\n",
+			wantContains: []string{"password"},
+			wantMissing:  []string{"123456"},
+		},
+		{
+			name:         "comments and blank lines",
+			input:        "# breached passwords\n\n  " + numbersSHA1 + "  \n",
+			wantContains: []string{"123456"},
+		},
+		{name: "short hash", input: "5BAA61E4\n", wantErr: "line 1: expected a SHA-1 hash"},
+		{name: "not hex", input: "# header\n" + strings.Repeat("Z", 40) + "\n", wantErr: "line 2: expected a SHA-1 hash"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			list, err := ReadBreachedList(strings.NewReader(tt.input))
+
+			// Assert
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, list)
+				return
+			}
+			require.NoError(t, err)
+			for _, password := range tt.wantContains {
+				assert.True(t, list.Contains(password), password)
+			}
+			for _, password := range tt.wantMissing {
+				assert.False(t, list.Contains(password), password)
+			}
+		})
+	}
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	// Setup
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte(passwordSHA1+"\n"), 0o600))
+
+	// Execute
+	list, err := LoadBreachedList(path)
+	_, missingErr := LoadBreachedList(filepath.Join(t.TempDir(), "missing.txt"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, list.Contains("password"))
+	assert.Error(t, missingErr)
+}
+
+func TestBreachedList_NilContainsNothing(t *testing.T) {
+	// Setup
+	var list *BreachedList
+
+	// Execute & Assert
+	assert.False(t, list.Contains("password"))
+}
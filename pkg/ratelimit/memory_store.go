@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = 1000
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+	window     time.Duration
+}
+
+// MemoryStore is a token bucket limiter kept in process memory. It is the
+// default store and is only accurate for single instance deployments.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	calls   int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (s *MemoryStore) Allow(_ context.Context, key string, limit int, window time.Duration) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	// tokens refill continuously so that a full bucket is regained after one window
+	rate := float64(limit) / window.Seconds()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit), lastRefill: now, window: window}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit), b.tokens+now.Sub(b.lastRefill).Seconds()*rate)
+	b.lastRefill = now
+
+	result := Result{Limit: limit}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	}
+
+	result.Remaining = int(math.Floor(b.tokens))
+	result.ResetAfter = secondsToDuration((float64(limit) - b.tokens) / rate)
+
+	return result, nil
+}
+
+// sweep periodically drops buckets that have refilled completely, since they
+// are indistinguishable from buckets that were never created.
+func (s *MemoryStore) sweep(now time.Time) {
+	s.calls++
+	if s.calls < sweepInterval {
+		return
+	}
+	s.calls = 0
+
+	for key, b := range s.buckets {
+		if now.Sub(b.lastRefill) >= b.window {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Allow(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int
+		calls         int
+		wantAllowed   int
+		wantRemaining int
+	}{
+		{name: "under limit", limit: 5, calls: 3, wantAllowed: 3, wantRemaining: 2},
+		{name: "exactly at limit", limit: 3, calls: 3, wantAllowed: 3, wantRemaining: 0},
+		{name: "over limit", limit: 2, calls: 5, wantAllowed: 2, wantRemaining: 0},
+		{name: "single request limit", limit: 1, calls: 2, wantAllowed: 1, wantRemaining: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			store := NewMemoryStore()
+
+			// Execute
+			var allowed int
+			var last Result
+			for i := 0; i < tt.calls; i++ {
+				result, err := store.Allow(context.Background(), "key", tt.limit, time.Hour)
+				assert.NoError(t, err)
+				if result.Allowed {
+					allowed++
+				}
+				last = result
+			}
+
+			// Assert
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, tt.wantRemaining, last.Remaining)
+			assert.Equal(t, tt.limit, last.Limit)
+			if tt.calls > tt.limit {
+				assert.False(t, last.Allowed)
+				assert.Greater(t, last.RetryAfter, time.Duration(0))
+			}
+		})
+	}
+}
+
+func TestMemoryStore_Allow_KeysAreIndependent(t *testing.T) {
+	// Setup
+	store := NewMemoryStore()
+
+	// Execute
+	first, _ := store.Allow(context.Background(), "a", 1, time.Hour)
+	second, _ := store.Allow(context.Background(), "a", 1, time.Hour)
+	other, _ := store.Allow(context.Background(), "b", 1, time.Hour)
+
+	// Assert
+	assert.True(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	assert.True(t, other.Allowed)
+}
+
+func TestMemoryStore_Allow_Refills(t *testing.T) {
+	// Setup
+	store := NewMemoryStore()
+	window := 50 * time.Millisecond
+
+	// Execute
+	first, _ := store.Allow(context.Background(), "key", 1, window)
+	blocked, _ := store.Allow(context.Background(), "key", 1, window)
+	time.Sleep(blocked.RetryAfter + 10*time.Millisecond)
+	refilled, _ := store.Allow(context.Background(), "key", 1, window)
+
+	// Assert
+	assert.True(t, first.Allowed)
+	assert.False(t, blocked.Allowed)
+	assert.LessOrEqual(t, blocked.RetryAfter, window)
+	assert.True(t, refilled.Allowed)
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	// Setup
+	store := NewMemoryStore()
+	now := time.Now()
+	store.buckets["stale"] = &bucket{lastRefill: now.Add(-2 * time.Minute), window: time.Minute}
+	store.buckets["fresh"] = &bucket{lastRefill: now, window: time.Minute}
+	store.calls = sweepInterval - 1
+
+	// Execute
+	store.sweep(now)
+
+	// Assert
+	assert.NotContains(t, store.buckets, "stale")
+	assert.Contains(t, store.buckets, "fresh")
+	assert.Equal(t, 0, store.calls)
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoStore is a fixed window counter shared through MongoDB, so every
+// instance of the API sees the same counts. Expired windows are removed by the
+// TTL index on expires_at.
+type MongoStore struct {
+	collection *mongo.Collection
+}
+
+func NewMongoStore(db *mongo.Database) *MongoStore {
+	return &MongoStore{
+		collection: db.Collection("rate_limits"),
+	}
+}
+
+func (s *MongoStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := time.Now()
+	windowStart := now.Truncate(window)
+	windowEnd := windowStart.Add(window)
+
+	filter := bson.M{"_id": fmt.Sprintf("%s:%d", key, windowStart.Unix())}
+	update := bson.M{
+		"$inc":         bson.M{"count": 1},
+		"$setOnInsert": bson.M{"expires_at": windowEnd},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var counter struct {
+		Count int `bson:"count"`
+	}
+	if err := s.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&counter); err != nil {
+		return Result{}, err
+	}
+
+	result := Result{
+		Allowed:    counter.Count <= limit,
+		Limit:      limit,
+		Remaining:  max(0, limit-counter.Count),
+		ResetAfter: windowEnd.Sub(now),
+	}
+
+	if !result.Allowed {
+		result.RetryAfter = result.ResetAfter
+	}
+
+	return result, nil
+}
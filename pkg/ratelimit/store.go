@@ -0,0 +1,20 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Result describes the outcome of a single rate limit check.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Store keeps rate limit state. Implementations must be safe for concurrent use.
+type Store interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (Result, error)
+}